@@ -67,7 +67,15 @@ func main() {
 	defer cancel()
 
 	// Create Discogs client
-	httpClient, err := client.NewWithContext(ctx)
+	httpClient, err := client.NewWithContext(ctx, client.Options{
+		BaseURL:      c.API.BaseURL,
+		AuthorizeURL: c.API.AuthorizeURL,
+		Retry: client.RetryPolicy{
+			MaxAttempts: c.API.Retry.MaxAttempts,
+			BaseDelay:   time.Duration(c.API.Retry.BaseDelayMs) * time.Millisecond,
+			MaxDelay:    time.Duration(c.API.Retry.MaxDelayMs) * time.Millisecond,
+		},
+	})
 	if err != nil {
 		log.Fatalf("Failed to initialize Discogs client: %v", err)
 	}
@@ -1,8 +1,10 @@
 package configs
 
 import (
+	"cmp"
 	_ "embed"
 	"log"
+	"time"
 
 	"github.com/knadh/koanf"
 	"github.com/knadh/koanf/parsers/yaml"
@@ -23,9 +25,45 @@ type GridConfig struct {
 	NumOfCols int `koanf:"cols"`
 }
 
+type RetryConfig struct {
+	MaxAttempts int `koanf:"max_attempts"`
+	BaseDelayMs int `koanf:"base_delay_ms"`
+	MaxDelayMs  int `koanf:"max_delay_ms"`
+}
+
+type APIConfig struct {
+	BaseURL      string      `koanf:"base_url"`
+	AuthorizeURL string      `koanf:"authorize_url"`
+	Retry        RetryConfig `koanf:"retry"`
+}
+
+const (
+	// DefaultExportDir is where exported inventory CSVs are saved unless export_dir is set.
+	DefaultExportDir = "exports"
+	// DefaultPollIntervalSec is how often running inventory jobs are checked unless poll_interval is set.
+	DefaultPollIntervalSec = 10
+)
+
+type InventoryConfig struct {
+	ExportDir       string `koanf:"export_dir"`
+	PollIntervalSec int    `koanf:"poll_interval"`
+}
+
+// ExportDirectory returns ExportDir, or DefaultExportDir when it isn't set
+func (c InventoryConfig) ExportDirectory() string {
+	return cmp.Or(c.ExportDir, DefaultExportDir)
+}
+
+// PollInterval returns PollIntervalSec as a duration, or DefaultPollIntervalSec when it isn't set
+func (c InventoryConfig) PollInterval() time.Duration {
+	return time.Duration(cmp.Or(c.PollIntervalSec, DefaultPollIntervalSec)) * time.Second
+}
+
 type AppConfig struct {
-	Grid       GridConfig `koanf:"grid"`
-	UpdateFreq int        `koanf:"update_frequency"`
+	Grid       GridConfig      `koanf:"grid"`
+	UpdateFreq int             `koanf:"update_frequency"`
+	API        APIConfig       `koanf:"api"`
+	Inventory  InventoryConfig `koanf:"inventory"`
 }
 
 func LoadConfig() (*AppConfig, error) {
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// FoldersPath is the API path for the user's collection folders.
+	FoldersPath string = "/users/%s/collection/folders"
+	// FolderPath is the API path for a single collection folder.
+	FolderPath string = "/users/%s/collection/folders/%d"
+	// FolderReleasePath is the API path for adding a release to a folder.
+	FolderReleasePath string = "/users/%s/collection/folders/%d/releases/%d"
+	// CollectionValuePath is the API path for the estimated value of the user's collection.
+	CollectionValuePath string = "/users/%s/collection/value"
+	// FieldsPath is the API path for the user's collection custom fields.
+	FieldsPath string = "/users/%s/collection/fields"
+	// FieldValuePath is the API path for a custom field value of a release instance.
+	FieldValuePath string = "/users/%s/collection/folders/%d/releases/%d/instances/%d/fields/%d"
+	// ReleaseInstancesPath is the API path for every copy of a release in the collection.
+	ReleaseInstancesPath string = "/users/%s/collection/releases/%d"
+	// InstancePath is the API path for a single copy of a release in the collection.
+	InstancePath string = "/users/%s/collection/folders/%d/releases/%d/instances/%d"
+
+	// AllFolderId is the virtual folder holding every release of the collection.
+	AllFolderId = 0
+	// UncategorizedFolderId is the folder new releases go to by default.
+	UncategorizedFolderId = 1
+	// MaxRating is the highest rating a release can get; 0 clears the rating.
+	MaxRating = 5
+)
+
+var (
+	// ErrInvalidRating is returned for ratings outside 0 to MaxRating.
+	ErrInvalidRating = errors.New("rating must be between 0 and 5")
+	// ErrInvalidFieldOption is returned when a dropdown field is set to a value that is not one of its options.
+	ErrInvalidFieldOption = errors.New("value is not one of the field's options")
+)
+
+// GetFolders fetches the user's collection folders.
+func (c *DiscogsClient) GetFolders(ctx context.Context) ([]dto.FolderModel, error) {
+	var folders dto.FoldersBaseDto
+	if err := c.getJSON(ctx, c.apiURL(FoldersPath, c.Identity.Username), &folders); err != nil {
+		return nil, fmt.Errorf("failed to fetch folders: %w", err)
+	}
+	return dto.MapFolders(folders.Folders)
+}
+
+// GetFolderReleases fetches every page of the releases in a collection folder.
+func (c *DiscogsClient) GetFolderReleases(ctx context.Context, folderId int, progress ProgressFunc) ([]dto.ReleaseModel, error) {
+	fields, err := c.GetCollectionFields(ctx)
+	if err != nil {
+		// The default fields match most collections, so don't fail the whole collection
+		fields = dto.DefaultCollectionFields
+	}
+
+	releases, err := fetchAllPages(ctx, c, c.apiURL(CollectionPath, c.Identity.Username, folderId), progress,
+		func(page *dto.CollectionBaseDto) (dto.DiscogsPaginationDto, []dto.DiscogsReleaseDto[[]dto.NoteDto]) {
+			return page.Pagination, page.Releases
+		})
+	if err != nil {
+		if folderId == AllFolderId {
+			return nil, fmt.Errorf("failed to fetch collection: %w", err)
+		}
+		return nil, fmt.Errorf("failed to fetch folder %d: %w", folderId, err)
+	}
+
+	// Map the DTO to the model
+	return dto.MapCollectionReleases(releases, fields)
+}
+
+// GetCollectionFields fetches the user's collection custom fields once and caches them.
+func (c *DiscogsClient) GetCollectionFields(ctx context.Context) ([]dto.CollectionFieldModel, error) {
+	c.fieldsMu.Lock()
+	defer c.fieldsMu.Unlock()
+	if c.fields != nil {
+		return c.fields, nil
+	}
+
+	var fields dto.CollectionFieldsBaseDto
+	if err := c.getJSON(ctx, c.apiURL(FieldsPath, c.Identity.Username), &fields); err != nil {
+		return nil, fmt.Errorf("failed to fetch collection fields: %w", err)
+	}
+	models, err := dto.MapCollectionFields(fields.Fields)
+	if err != nil {
+		return nil, err
+	}
+	c.fields = models
+	return models, nil
+}
+
+// SetFieldValue sets a custom field of a copy of a release. Dropdown fields only accept one of their options or an empty value.
+func (c *DiscogsClient) SetFieldValue(ctx context.Context, folderId, releaseId, instanceId int, field dto.CollectionFieldModel, value string) error {
+	if field.Type == dto.FieldTypeDropdown && value != "" && !slices.Contains(field.Options, value) {
+		return fmt.Errorf("%s: %w", field.Name, ErrInvalidFieldOption)
+	}
+
+	fieldURL := c.apiURL(FieldValuePath, c.Identity.Username, folderId, releaseId, instanceId, field.Id) + "?" + url.Values{"value": {value}}.Encode()
+	if err := c.doJSON(ctx, "POST", fieldURL, nil, nil); err != nil {
+		return fmt.Errorf("failed to set %s of release %d: %w", field.Name, releaseId, err)
+	}
+	return nil
+}
+
+// folderName is the request body for creating and renaming folders
+type folderName struct {
+	Name string `json:"name"`
+}
+
+// CreateFolder creates a new collection folder.
+func (c *DiscogsClient) CreateFolder(ctx context.Context, name string) (dto.FolderModel, error) {
+	var folder dto.DiscogsFolderDto
+	if err := c.doJSON(ctx, "POST", c.apiURL(FoldersPath, c.Identity.Username), folderName{Name: name}, &folder); err != nil {
+		return dto.FolderModel{}, fmt.Errorf("failed to create folder %q: %w", name, err)
+	}
+	return dto.MapFolder(folder), nil
+}
+
+// RenameFolder renames a collection folder. The All and Uncategorized folders can't be renamed.
+func (c *DiscogsClient) RenameFolder(ctx context.Context, folderId int, name string) (dto.FolderModel, error) {
+	var folder dto.DiscogsFolderDto
+	if err := c.doJSON(ctx, "POST", c.apiURL(FolderPath, c.Identity.Username, folderId), folderName{Name: name}, &folder); err != nil {
+		return dto.FolderModel{}, fmt.Errorf("failed to rename folder %d: %w", folderId, err)
+	}
+	return dto.MapFolder(folder), nil
+}
+
+// DeleteFolder deletes a collection folder. Only empty folders can be deleted.
+func (c *DiscogsClient) DeleteFolder(ctx context.Context, folderId int) error {
+	if err := c.doJSON(ctx, "DELETE", c.apiURL(FolderPath, c.Identity.Username, folderId), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete folder %d: %w", folderId, err)
+	}
+	return nil
+}
+
+// AddToFolder adds a release to a collection folder and returns the new instance ID.
+func (c *DiscogsClient) AddToFolder(ctx context.Context, folderId, releaseId int) (int, error) {
+	var instance dto.DiscogsInstanceDto
+	if err := c.doJSON(ctx, "POST", c.apiURL(FolderReleasePath, c.Identity.Username, folderId, releaseId), nil, &instance); err != nil {
+		return 0, fmt.Errorf("failed to add release %d to folder %d: %w", releaseId, folderId, err)
+	}
+	return instance.InstanceId, nil
+}
+
+// GetReleaseInstances fetches every copy of a release in the collection, e.g. to show a copy that was just added.
+func (c *DiscogsClient) GetReleaseInstances(ctx context.Context, releaseId int) ([]dto.ReleaseModel, error) {
+	fields, err := c.GetCollectionFields(ctx)
+	if err != nil {
+		fields = dto.DefaultCollectionFields
+	}
+
+	releases, err := fetchAllPages(ctx, c, c.apiURL(ReleaseInstancesPath, c.Identity.Username, releaseId), nil,
+		func(page *dto.CollectionBaseDto) (dto.DiscogsPaginationDto, []dto.DiscogsReleaseDto[[]dto.NoteDto]) {
+			return page.Pagination, page.Releases
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch instances of release %d: %w", releaseId, err)
+	}
+	return dto.MapCollectionReleases(releases, fields)
+}
+
+// DeleteInstance removes a copy of a release from the collection.
+func (c *DiscogsClient) DeleteInstance(ctx context.Context, folderId, releaseId, instanceId int) error {
+	if err := c.doJSON(ctx, "DELETE", c.apiURL(InstancePath, c.Identity.Username, folderId, releaseId, instanceId), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete instance %d of release %d: %w", instanceId, releaseId, err)
+	}
+	return nil
+}
+
+// MoveInstance moves a copy of a release from its folder to another one.
+func (c *DiscogsClient) MoveInstance(ctx context.Context, folderId, releaseId, instanceId, toFolderId int) error {
+	body := struct {
+		FolderId int `json:"folder_id"`
+	}{FolderId: toFolderId}
+	if err := c.doJSON(ctx, "POST", c.apiURL(InstancePath, c.Identity.Username, folderId, releaseId, instanceId), body, nil); err != nil {
+		return fmt.Errorf("failed to move instance %d of release %d to folder %d: %w", instanceId, releaseId, toFolderId, err)
+	}
+	return nil
+}
+
+// RateInstance sets the rating of a copy of a release in the collection.
+func (c *DiscogsClient) RateInstance(ctx context.Context, folderId, releaseId, instanceId int, rating uint8) error {
+	if rating > MaxRating {
+		return ErrInvalidRating
+	}
+	body := struct {
+		Rating uint8 `json:"rating"`
+	}{Rating: rating}
+	if err := c.doJSON(ctx, "POST", c.apiURL(InstancePath, c.Identity.Username, folderId, releaseId, instanceId), body, nil); err != nil {
+		return fmt.Errorf("failed to rate release %d: %w", releaseId, err)
+	}
+	return nil
+}
+
+// GetCollectionValue fetches the minimum, median and maximum value of the collection.
+func (c *DiscogsClient) GetCollectionValue(ctx context.Context) (dto.CollectionValueModel, error) {
+	var value dto.CollectionValueDto
+	if err := c.getJSON(ctx, c.apiURL(CollectionValuePath, c.Identity.Username), &value); err != nil {
+		return dto.CollectionValueModel{}, fmt.Errorf("failed to fetch collection value: %w", err)
+	}
+	return dto.MapCollectionValue(value, time.Now())
+}
@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// ReleasePath is the API path for a single release.
+	ReleasePath string = "/releases/%d"
+	// MasterPath is the API path for a master release.
+	MasterPath string = "/masters/%d"
+	// MasterVersionsPath is the API path for the versions of a master release.
+	MasterVersionsPath string = "/masters/%d/versions"
+	// ArtistPath is the API path for an artist.
+	ArtistPath string = "/artists/%d"
+	// ArtistReleasesPath is the API path for an artist's discography.
+	ArtistReleasesPath string = "/artists/%d/releases"
+	// LabelPath is the API path for a label.
+	LabelPath string = "/labels/%d"
+	// LabelReleasesPath is the API path for a label's catalog.
+	LabelReleasesPath string = "/labels/%d/releases"
+	// SearchPath is the API path for the database search.
+	SearchPath string = "/database/search"
+)
+
+// GetRelease fetches the full details of a release.
+func (c *DiscogsClient) GetRelease(ctx context.Context, id int) (dto.ReleaseDetailModel, error) {
+	var release dto.DiscogsReleaseDetailDto
+	if err := c.getJSON(ctx, c.apiURL(ReleasePath, id), &release); err != nil {
+		return dto.ReleaseDetailModel{}, fmt.Errorf("failed to fetch release %d: %w", id, err)
+	}
+	return dto.MapReleaseDetail(release)
+}
+
+// GetMaster fetches a master release.
+func (c *DiscogsClient) GetMaster(ctx context.Context, id int) (dto.MasterModel, error) {
+	var master dto.DiscogsMasterDto
+	if err := c.getJSON(ctx, c.apiURL(MasterPath, id), &master); err != nil {
+		return dto.MasterModel{}, fmt.Errorf("failed to fetch master %d: %w", id, err)
+	}
+	return dto.MapMaster(master)
+}
+
+// MasterVersionsQuery filters and sorts the versions of a master release.
+// Empty fields are not sent.
+type MasterVersionsQuery struct {
+	Page     int
+	Format   string
+	Label    string
+	Country  string
+	Released string
+	// Sort is one of released, title, format, label, catno or country
+	Sort string
+	// SortOrder is asc or desc
+	SortOrder string
+}
+
+// MasterVersionSorts are the sort keys accepted by the versions endpoint.
+var MasterVersionSorts = []string{"released", "title", "format", "label", "catno", "country"}
+
+// GetMasterVersions fetches one page of the versions of a master release.
+func (c *DiscogsClient) GetMasterVersions(ctx context.Context, id int, query MasterVersionsQuery) (dto.MasterVersionsModel, error) {
+	params := url.Values{}
+	for key, value := range map[string]string{
+		"format":     query.Format,
+		"label":      query.Label,
+		"country":    query.Country,
+		"released":   query.Released,
+		"sort":       query.Sort,
+		"sort_order": query.SortOrder,
+	} {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+
+	page, err := pageURL(c.apiURL(MasterVersionsPath, id)+"?"+params.Encode(), max(query.Page, 1))
+	if err != nil {
+		return dto.MasterVersionsModel{}, err
+	}
+
+	var versions dto.MasterVersionsBaseDto
+	if err := c.getJSON(ctx, page, &versions); err != nil {
+		return dto.MasterVersionsModel{}, fmt.Errorf("failed to fetch versions of master %d: %w", id, err)
+	}
+	return dto.MapMasterVersions(versions)
+}
+
+// GetArtist fetches the profile of an artist.
+func (c *DiscogsClient) GetArtist(ctx context.Context, id int) (dto.ArtistModel, error) {
+	var artist dto.DiscogsArtistDto
+	if err := c.getJSON(ctx, c.apiURL(ArtistPath, id), &artist); err != nil {
+		return dto.ArtistModel{}, fmt.Errorf("failed to fetch artist %d: %w", id, err)
+	}
+	return dto.MapArtist(artist)
+}
+
+// GetArtistReleases fetches one page of an artist's discography sorted by year.
+func (c *DiscogsClient) GetArtistReleases(ctx context.Context, id, page int) (dto.ArtistReleasesModel, error) {
+	pageUrl, err := pageURL(c.apiURL(ArtistReleasesPath, id)+"?sort=year&sort_order=asc", max(page, 1))
+	if err != nil {
+		return dto.ArtistReleasesModel{}, err
+	}
+
+	var releases dto.ArtistReleasesBaseDto
+	if err := c.getJSON(ctx, pageUrl, &releases); err != nil {
+		return dto.ArtistReleasesModel{}, fmt.Errorf("failed to fetch releases of artist %d: %w", id, err)
+	}
+	return dto.MapArtistReleases(releases)
+}
+
+// GetLabel fetches the profile of a label.
+func (c *DiscogsClient) GetLabel(ctx context.Context, id int) (dto.LabelModel, error) {
+	var label dto.DiscogsLabelDto
+	if err := c.getJSON(ctx, c.apiURL(LabelPath, id), &label); err != nil {
+		return dto.LabelModel{}, fmt.Errorf("failed to fetch label %d: %w", id, err)
+	}
+	return dto.MapLabel(label)
+}
+
+// GetLabelReleases fetches one page of a label's catalog. The endpoint cannot
+// sort, so callers sort the pages they collected by catalog number.
+func (c *DiscogsClient) GetLabelReleases(ctx context.Context, id, page int) (dto.LabelReleasesModel, error) {
+	pageUrl, err := pageURL(c.apiURL(LabelReleasesPath, id), max(page, 1))
+	if err != nil {
+		return dto.LabelReleasesModel{}, err
+	}
+
+	var releases dto.LabelReleasesBaseDto
+	if err := c.getJSON(ctx, pageUrl, &releases); err != nil {
+		return dto.LabelReleasesModel{}, fmt.Errorf("failed to fetch releases of label %d: %w", id, err)
+	}
+	return dto.MapLabelReleasePage(releases)
+}
+
+// SearchQuery holds the database search filters. Empty fields are not sent.
+type SearchQuery struct {
+	Page  int
+	Query string
+	// Type is one of release, master, artist or label
+	Type    string
+	Artist  string
+	Title   string
+	Label   string
+	CatNo   string
+	Barcode string
+	Format  string
+	Country string
+	Year    string
+}
+
+// SearchTypes are the result types accepted by the search endpoint.
+var SearchTypes = []string{"release", "master", "artist", "label"}
+
+// Search fetches one page of database search results.
+func (c *DiscogsClient) Search(ctx context.Context, query SearchQuery) (dto.SearchResultsModel, error) {
+	params := url.Values{}
+	for key, value := range map[string]string{
+		"q":       query.Query,
+		"type":    query.Type,
+		"artist":  query.Artist,
+		"title":   query.Title,
+		"label":   query.Label,
+		"catno":   query.CatNo,
+		"barcode": query.Barcode,
+		"format":  query.Format,
+		"country": query.Country,
+		"year":    query.Year,
+	} {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+
+	page, err := pageURL(c.apiURL(SearchPath)+"?"+params.Encode(), max(query.Page, 1))
+	if err != nil {
+		return dto.SearchResultsModel{}, err
+	}
+
+	var results dto.SearchBaseDto
+	if err := c.getJSON(ctx, page, &results); err != nil {
+		return dto.SearchResultsModel{}, fmt.Errorf("failed to search: %w", err)
+	}
+	return dto.MapSearchResults(results)
+}
@@ -1,8 +1,12 @@
 package client
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 
 	"github.com/s-froghyar/disgo-tui/internal/dto"
 )
@@ -13,92 +17,133 @@ const (
 	CollectionSource DataSource = iota
 	WishlistSource
 	OrdersSource
+	InventorySource
+	ListsSource
 
-	// CollectionURL is the URL for the user's collection.
-	CollectionURL string = "https://api.discogs.com/users/%s/collection/folders/0/releases"
-	// WishlistURL is the URL for the user's wishlist.
-	WishlistURL string = "https://api.discogs.com/users/%s/wants"
-	// OrdersURL is the URL for the user's orders.
-	OrdersURL string = "https://api.discogs.com/users/%s/orders"
+	// IdentityPath is the API path for the authenticated user's identity.
+	IdentityPath string = "/oauth/identity"
+	// CollectionPath is the API path for the releases in one of the user's collection folders.
+	CollectionPath string = "/users/%s/collection/folders/%d/releases"
+	// WishlistPath is the API path for the user's wishlist.
+	WishlistPath string = "/users/%s/wants"
+	// OrdersPath is the API path for the marketplace orders of the user.
+	OrdersPath string = "/marketplace/orders"
 )
 
-func (c *DiscogsClient) GetCollection() ([]dto.ReleaseModel, error) {
-	// Get the collection
-	resp, err := c.Get(fmt.Sprintf(CollectionURL, c.Identity.Username))
-	if err != nil {
-		fmt.Printf("Error at Get: %v \n", err)
-		return nil, err
+// APIError is returned when the Discogs API answers with an unexpected status.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("API returned status %d", e.StatusCode)
 	}
-	defer resp.Body.Close()
+	return fmt.Sprintf("API returned status %d: %s", e.StatusCode, e.Message)
+}
 
-	// Decode the response
-	var collectionDto dto.CollectionBaseDto
-	decoder := json.NewDecoder(resp.Body)
-	err = decoder.Decode(&collectionDto)
-	if err != nil {
-		fmt.Printf("Error at decoding body: %v \n", err)
-		return nil, err
+// checkResponse returns an *APIError for responses outside the 2xx range
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+	// Discogs explains most errors in a JSON message
+	var apiErr struct {
+		Message string `json:"message"`
 	}
+	json.NewDecoder(resp.Body).Decode(&apiErr)
+	return &APIError{StatusCode: resp.StatusCode, Message: apiErr.Message}
+}
 
-	// Map the DTO to the model
-	collection, err := dto.MapCollectionReleases(collectionDto.Releases)
+// doJSON sends body (if any) as JSON and decodes the JSON response into out (if any).
+func (c *DiscogsClient) doJSON(ctx context.Context, method, url string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
 	if err != nil {
-		fmt.Printf("Error at MapReleases: %v \n", err)
-		return nil, err
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
-	return collection, nil
-}
 
-func (c *DiscogsClient) GetWishlist() ([]dto.ReleaseModel, error) {
-	// Get the wish list
-	resp, err := c.Get(fmt.Sprintf(WishlistURL, c.Identity.Username))
+	resp, err := c.Do(req)
 	if err != nil {
-		fmt.Printf("Error at Get: %v \n", err)
-		return nil, err
+		return fmt.Errorf("error at %s: %w", method, err)
 	}
 	defer resp.Body.Close()
 
-	// Decode the response
-	var wantsDto dto.WishlistBaseDto
-	decoder := json.NewDecoder(resp.Body)
-	err = decoder.Decode(&wantsDto)
-	if err != nil {
-		fmt.Printf("Error at decoding body: %v \n", err)
-		return nil, err
+	if err := checkResponse(resp); err != nil {
+		return err
 	}
 
-	// Map the DTO to the model
-	wants, err := dto.MapWishlistReleases(wantsDto.Wants)
-	if err != nil {
-		fmt.Printf("Error at MapReleases: %v \n", err)
-		return nil, err
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
 	}
-	return wants, nil
+	return nil
 }
 
-func (c *DiscogsClient) GetOrders() ([]dto.ReleaseModel, error) {
-	// Get the orders
-	resp, err := c.Get(fmt.Sprintf(OrdersURL, c.Identity.Username))
-	if err != nil {
-		fmt.Printf("Error at Get: %v \n", err)
-		return nil, err
-	}
-	defer resp.Body.Close()
+// getJSON performs a GET request and decodes the JSON response into out.
+func (c *DiscogsClient) getJSON(ctx context.Context, url string, out any) error {
+	return c.doJSON(ctx, "GET", url, nil, out)
+}
+
+// GetCollectionWithContext fetches every page of the user's collection.
+func (c *DiscogsClient) GetCollectionWithContext(ctx context.Context, progress ProgressFunc) ([]dto.ReleaseModel, error) {
+	return c.GetFolderReleases(ctx, AllFolderId, progress)
+}
+
+// GetCollection maintains backward compatibility
+func (c *DiscogsClient) GetCollection() ([]dto.ReleaseModel, error) {
+	return c.GetCollectionWithContext(context.Background(), nil)
+}
 
-	// Decode the response
-	var wantsDto dto.WishlistBaseDto
-	decoder := json.NewDecoder(resp.Body)
-	err = decoder.Decode(&wantsDto)
+// GetWishlistWithContext fetches every page of the user's wish list.
+func (c *DiscogsClient) GetWishlistWithContext(ctx context.Context, progress ProgressFunc) ([]dto.ReleaseModel, error) {
+	wants, err := fetchAllPages(ctx, c, c.apiURL(WishlistPath, c.Identity.Username), progress,
+		func(page *dto.WishlistBaseDto) (dto.DiscogsPaginationDto, []dto.DiscogsReleaseDto[string]) {
+			return page.Pagination, page.Wants
+		})
 	if err != nil {
-		fmt.Printf("Error at decoding body: %v \n", err)
-		return nil, err
+		return nil, fmt.Errorf("failed to fetch wish list: %w", err)
 	}
 
 	// Map the DTO to the model
-	wants, err := dto.MapWishlistReleases(wantsDto.Wants)
+	return dto.MapWishlistReleases(wants)
+}
+
+// GetWishlist maintains backward compatibility
+func (c *DiscogsClient) GetWishlist() ([]dto.ReleaseModel, error) {
+	return c.GetWishlistWithContext(context.Background(), nil)
+}
+
+// GetOrdersWithContext fetches every page of the user's marketplace orders,
+// most recently active first.
+func (c *DiscogsClient) GetOrdersWithContext(ctx context.Context, progress ProgressFunc) ([]dto.OrderModel, error) {
+	orders, err := fetchAllPages(ctx, c, c.apiURL(OrdersPath)+"?sort=last_activity&sort_order=desc", progress,
+		func(page *dto.OrdersBaseDto) (dto.DiscogsPaginationDto, []dto.OrderDto) {
+			return page.Pagination, page.Orders
+		})
 	if err != nil {
-		fmt.Printf("Error at MapReleases: %v \n", err)
-		return nil, err
+		return nil, fmt.Errorf("failed to fetch orders: %w", err)
 	}
-	return wants, nil
+
+	// Map the DTO to the model
+	return dto.MapOrders(orders)
+}
+
+// GetOrders maintains backward compatibility
+func (c *DiscogsClient) GetOrders() ([]dto.OrderModel, error) {
+	return c.GetOrdersWithContext(context.Background(), nil)
 }
@@ -14,14 +14,17 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/dghubble/oauth1"
-	"github.com/dghubble/oauth1/discogs"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
 )
 
 // Build-time variables (set during compilation)
@@ -36,12 +39,29 @@ const (
 	defaultTimeout = 30 * time.Second
 	configFileName = "discogs_tui_config.enc"
 	defaultPort    = "8080"
+
+	// DefaultBaseURL is the root of the Discogs API.
+	DefaultBaseURL = "https://api.discogs.com"
+	// DefaultAuthorizeURL is the page where users authorize the OAuth request token.
+	DefaultAuthorizeURL = "https://www.discogs.com/oauth/authorize"
 )
 
 var (
 	ErrTokenGenerationFailed = errors.New("failed to generate OAuth token")
+
+	errNoToken = errors.New("no OAuth token available")
 )
 
+// Options configures a DiscogsClient. Zero values fall back to defaults.
+type Options struct {
+	// Retry configures how transient API failures are retried
+	Retry RetryPolicy
+	// BaseURL is the API root, e.g. an httptest server standing in for Discogs
+	BaseURL string
+	// AuthorizeURL is the OAuth authorization page opened in the browser
+	AuthorizeURL string
+}
+
 type DiscogsIdentity struct {
 	Id           int    `json:"id"`
 	Username     string `json:"username"`
@@ -63,6 +83,7 @@ type DiscogsClient struct {
 	config            oauth1.Config
 	consumerKey       string
 	consumerSecretKey string
+	baseURL           string
 	localPort         string
 	requestToken      string
 	requestSecret     string
@@ -70,24 +91,57 @@ type DiscogsClient struct {
 	doneVerifying     bool
 	token             *oauth1.Token
 	oauthComplete     chan error
+
+	// rateLimiter schedules all requests within the Discogs budget
+	rateLimiter *RateLimiter
+
+	// fields caches the user's collection custom fields
+	fieldsMu sync.Mutex
+	fields   []dto.CollectionFieldModel
+
+	// currency caches the user's marketplace currency
+	currencyMu sync.Mutex
+	currency   string
 }
 
 type customTransport struct {
 	Transport http.RoundTripper
 	client    *DiscogsClient
+	apiHost   string
 }
 
+// RoundTrip signs the request with HMAC-SHA1 through the OAuth1 transport
+// before handing it to the wrapped Transport. Requests to other hosts, e.g.
+// thumbnails from the image CDN, are sent unsigned so the tokens stay with the API.
 func (t *customTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTripper should not modify the given request, clone it
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", fmt.Sprintf("DiscosTUI/%s", version))
+	if req.URL.Host != t.apiHost {
+		return t.Transport.RoundTrip(req)
+	}
+
 	if t.client.token == nil {
-		return nil, errors.New("no OAuth token available")
+		return nil, errNoToken
 	}
 
-	ts := time.Now().Unix()
-	req.Header.Set("User-Agent", fmt.Sprintf("DiscosTUI/%s", version))
-	req.Header.Set("Authorization", fmt.Sprintf(`OAuth oauth_consumer_key="%v",oauth_nonce="%v",oauth_token="%v",oauth_signature="%v&%v",oauth_signature_method="PLAINTEXT",oauth_timestamp="%v"`,
-		t.client.consumerKey, ts, t.client.token.Token, t.client.consumerSecretKey, t.client.token.TokenSecret, ts))
+	// oauth1 picks its base transport up from the context client
+	ctx := context.WithValue(req.Context(), oauth1.HTTPClient, &http.Client{Transport: t.Transport})
+	signer := oauth1.NewClient(ctx, &t.client.config, t.client.token)
+	return signer.Transport.RoundTrip(req)
+}
 
-	return t.Transport.RoundTrip(req)
+// newOAuthConfig returns the OAuth1 configuration signing requests with
+// HMAC-SHA1 and a random nonce per request.
+func newOAuthConfig(consumerKey, consumerSecret, callbackURL string, endpoint oauth1.Endpoint) oauth1.Config {
+	return oauth1.Config{
+		ConsumerKey:    consumerKey,
+		ConsumerSecret: consumerSecret,
+		CallbackURL:    callbackURL,
+		Endpoint:       endpoint,
+		Signer:         &oauth1.HMACSigner{ConsumerSecret: consumerSecret},
+		Noncer:         oauth1.Base64Noncer{},
+	}
 }
 
 // validateConfig validates that all required configuration is present
@@ -101,6 +155,21 @@ func (c *DiscogsClient) validateConfig() error {
 	return nil
 }
 
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// apiURL returns the absolute URL of an API path formatted with args
+func (c *DiscogsClient) apiURL(path string, args ...any) string {
+	return c.baseURL + fmt.Sprintf(path, args...)
+}
+
 // getAvailablePort finds an available port for the OAuth callback
 func getAvailablePort() string {
 	// Try default port first
@@ -132,15 +201,16 @@ func isPortAvailable(port string) bool {
 
 // New returns an authenticated http.Client for the Discogs API
 func New() (*DiscogsClient, error) {
-	return NewWithContext(context.Background())
+	return NewWithContext(context.Background(), Options{})
 }
 
 // NewWithContext returns an authenticated http.Client for the Discogs API with context support
-func NewWithContext(ctx context.Context) (*DiscogsClient, error) {
+func NewWithContext(ctx context.Context, opts Options) (*DiscogsClient, error) {
 	c := &DiscogsClient{
-		Client: &http.Client{
-			Timeout: defaultTimeout,
-		},
+		// Every attempt gets its own deadline from the retry policy, a client
+		// wide timeout would also count the backoff between attempts
+		Client:      &http.Client{},
+		rateLimiter: NewRateLimiter(defaultRateLimit, defaultRateWindow),
 	}
 
 	// Initialize API credentials
@@ -155,6 +225,19 @@ func NewWithContext(ctx context.Context) (*DiscogsClient, error) {
 		fmt.Println("Using embedded API credentials")
 	}
 
+	// Set API endpoints
+	// Priority: 1. Environment variables
+	//          2. Options (from the app config)
+	//          3. Public Discogs API
+	c.baseURL = firstNonEmpty(os.Getenv("DISCOGS_API_BASE_URL"), opts.BaseURL, DefaultBaseURL)
+	c.baseURL = strings.TrimSuffix(c.baseURL, "/")
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API base URL %q: %w", c.baseURL, err)
+	}
+	apiHost := base.Host
+	authorizeURL := firstNonEmpty(os.Getenv("DISCOGS_AUTHORIZE_URL"), opts.AuthorizeURL, DefaultAuthorizeURL)
+
 	// Set OAuth callback port
 	c.localPort = os.Getenv("LOCAL_PORT")
 	if c.localPort == "" {
@@ -166,6 +249,12 @@ func NewWithContext(ctx context.Context) (*DiscogsClient, error) {
 		return nil, err
 	}
 
+	c.config = newOAuthConfig(c.consumerKey, c.consumerSecretKey, "http://localhost:"+c.localPort, oauth1.Endpoint{
+		RequestTokenURL: c.baseURL + "/oauth/request_token",
+		AuthorizeURL:    authorizeURL,
+		AccessTokenURL:  c.baseURL + "/oauth/access_token",
+	})
+
 	fmt.Println("🎵 Welcome to Discogs TUI!")
 	fmt.Println("Looking for existing authentication...")
 
@@ -194,9 +283,17 @@ func NewWithContext(ctx context.Context) (*DiscogsClient, error) {
 	}
 
 	// Set up custom transport
-	c.Transport = &customTransport{
-		Transport: http.DefaultTransport,
-		client:    c,
+	c.Transport = &retryTransport{
+		Transport: &customTransport{
+			Transport: &rateLimitTransport{
+				Transport: http.DefaultTransport,
+				limiter:   c.rateLimiter,
+				apiHost:   apiHost,
+			},
+			client:  c,
+			apiHost: apiHost,
+		},
+		policy: opts.Retry.withDefaults(),
 	}
 
 	fmt.Println("Verifying authentication with Discogs...")
@@ -234,13 +331,6 @@ func NewWithContext(ctx context.Context) (*DiscogsClient, error) {
 
 // generateDiscogsTokenWithContext generates OAuth tokens with context support
 func (c *DiscogsClient) generateDiscogsTokenWithContext(ctx context.Context) error {
-	c.config = oauth1.Config{
-		ConsumerKey:    c.consumerKey,
-		ConsumerSecret: c.consumerSecretKey,
-		CallbackURL:    "http://localhost:" + c.localPort,
-		Endpoint:       discogs.Endpoint,
-	}
-
 	// Get request token
 	token, secret, err := c.config.RequestToken()
 	if err != nil {
@@ -304,8 +394,9 @@ func (c *DiscogsClient) generateDiscogsTokenWithContext(ctx context.Context) err
 	}
 }
 
-// openBrowser attempts to open the URL in the user's default browser
-func openBrowser(url string) error {
+// openBrowser attempts to open the URL in the user's default browser. It's a
+// variable so tests can play the user authorizing the app.
+var openBrowser = func(url string) error {
 	var cmd string
 	var args []string
 
@@ -592,7 +683,7 @@ func (c *DiscogsClient) printTokensToConsole() {
 
 // getIdentityWithContext gets user identity with context support
 func (c *DiscogsClient) getIdentityWithContext(ctx context.Context) error {
-	path := "https://api.discogs.com/oauth/identity"
+	path := c.apiURL(IdentityPath)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
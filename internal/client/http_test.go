@@ -0,0 +1,208 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dghubble/oauth1"
+)
+
+// The published example of https://dev.twitter.com/oauth/overview/creating-signatures
+const (
+	vectorConsumerKey    = "xvz1evFS4wEEPTGEFPHBog"
+	vectorConsumerSecret = "kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw"
+	vectorToken          = "370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb"
+	vectorTokenSecret    = "LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE"
+	vectorNonce          = "kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg"
+	vectorTimestamp      = "1318622958"
+	vectorURL            = "https://api.twitter.com/1/statuses/update.json?include_entities=true"
+	vectorBody           = "status=Hello%20Ladies%20%2b%20Gentlemen%2c%20a%20signed%20OAuth%20request%21"
+	vectorBaseString     = "POST&https%3A%2F%2Fapi.twitter.com%2F1%2Fstatuses%2Fupdate.json&include_entities%3Dtrue%26oauth_consumer_key%3Dxvz1evFS4wEEPTGEFPHBog%26oauth_nonce%3DkYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg%26oauth_signature_method%3DHMAC-SHA1%26oauth_timestamp%3D1318622958%26oauth_token%3D370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb%26oauth_version%3D1.0%26status%3DHello%2520Ladies%2520%252B%2520Gentlemen%252C%2520a%2520signed%2520OAuth%2520request%2521"
+	vectorSignature      = "tnnArxj06cWHq44gCs1OSKk/jLY="
+)
+
+type fixedNoncer string
+
+func (n fixedNoncer) Nonce() string { return string(n) }
+
+// recordingSigner keeps the signature base strings passed to the wrapped Signer
+type recordingSigner struct {
+	oauth1.Signer
+
+	mu       sync.Mutex
+	messages []string
+}
+
+func (s *recordingSigner) Sign(key, message string) (string, error) {
+	s.mu.Lock()
+	s.messages = append(s.messages, message)
+	s.mu.Unlock()
+	return s.Signer.Sign(key, message)
+}
+
+func (s *recordingSigner) last() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages[len(s.messages)-1]
+}
+
+// roundTripFunc captures requests instead of sending them
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// newSigningClient returns a client signing requests to apiHost with the production
+// OAuth config, a recorded signer and the test vector's credentials.
+func newSigningClient(apiHost string, noncer oauth1.Noncer) (*http.Client, *recordingSigner, *[]*http.Request) {
+	config := newOAuthConfig(vectorConsumerKey, vectorConsumerSecret, "http://localhost", oauth1.Endpoint{})
+	signer := &recordingSigner{Signer: config.Signer}
+	config.Signer = signer
+	if noncer != nil {
+		config.Noncer = noncer
+	}
+
+	var mu sync.Mutex
+	var sent []*http.Request
+	transport := &customTransport{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			sent = append(sent, req)
+			mu.Unlock()
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		}),
+		client: &DiscogsClient{
+			config: config,
+			token:  oauth1.NewToken(vectorToken, vectorTokenSecret),
+		},
+		apiHost: apiHost,
+	}
+	return &http.Client{Transport: transport}, signer, &sent
+}
+
+var timestampParam = regexp.MustCompile(`oauth_timestamp%3D(\d+)`)
+
+// authParams parses the oauth_* parameters of an Authorization header
+func authParams(t *testing.T, req *http.Request) map[string]string {
+	t.Helper()
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "OAuth ") {
+		t.Fatalf("Authorization = %q, want an OAuth header", header)
+	}
+	params := make(map[string]string)
+	for _, pair := range strings.Split(strings.TrimPrefix(header, "OAuth "), ", ") {
+		key, value, _ := strings.Cut(pair, "=")
+		value, err := url.PathUnescape(strings.Trim(value, `"`))
+		if err != nil {
+			t.Fatalf("bad Authorization parameter %q: %v", pair, err)
+		}
+		params[key] = value
+	}
+	return params
+}
+
+func TestSignatureMatchesPublishedVector(t *testing.T) {
+	client, signer, sent := newSigningClient("api.twitter.com", fixedNoncer(vectorNonce))
+
+	req, _ := http.NewRequest(http.MethodPost, vectorURL, strings.NewReader(vectorBody))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if _, err := client.Do(req); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	// The oauth1 package reads the clock itself, so the timestamp of the
+	// vector is swapped into the base string that was actually signed
+	baseString := timestampParam.ReplaceAllString(signer.last(), "oauth_timestamp%3D"+vectorTimestamp)
+	if baseString != vectorBaseString {
+		t.Errorf("signature base string =\n%s\nwant\n%s", baseString, vectorBaseString)
+	}
+	signature, err := signer.Signer.Sign(vectorTokenSecret, baseString)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if signature != vectorSignature {
+		t.Errorf("oauth_signature = %q, want %q", signature, vectorSignature)
+	}
+
+	// The header carries the signature of the base string with the real timestamp
+	params := authParams(t, (*sent)[0])
+	want, _ := signer.Signer.Sign(vectorTokenSecret, signer.last())
+	if params["oauth_signature"] != want {
+		t.Errorf("header oauth_signature = %q, want %q", params["oauth_signature"], want)
+	}
+	if params["oauth_signature_method"] != "HMAC-SHA1" || params["oauth_nonce"] != vectorNonce {
+		t.Errorf("header params = %v, want HMAC-SHA1 with the fixed nonce", params)
+	}
+}
+
+func TestNoncesAreUniqueWithinASecond(t *testing.T) {
+	client, _, sent := newSigningClient("api.discogs.com", nil)
+
+	for range 20 {
+		if _, err := client.Get("https://api.discogs.com/oauth/identity"); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+
+	nonces := make(map[string]bool)
+	perSecond := make(map[string]int)
+	for _, req := range *sent {
+		params := authParams(t, req)
+		if nonces[params["oauth_nonce"]] {
+			t.Fatalf("nonce %q was used twice", params["oauth_nonce"])
+		}
+		nonces[params["oauth_nonce"]] = true
+		perSecond[params["oauth_timestamp"]]++
+	}
+	// 20 quick requests can't spread over more than a few seconds
+	shared := false
+	for _, n := range perSecond {
+		shared = shared || n > 1
+	}
+	if !shared {
+		t.Errorf("timestamps = %v, want several requests signed within the same second", perSecond)
+	}
+}
+
+func TestSignatureEncodesReservedCharacters(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"a b", "a%20b"},
+		{"1+2", "1%2B2"},
+		{"~-._", "~-._"},
+		{"!*'()", "%21%2A%27%28%29"},
+		{"a/b&c=d", "a%2Fb%26c%3Dd"},
+		{"Café", "Caf%C3%A9"},
+	}
+
+	client, signer, _ := newSigningClient("api.discogs.com", nil)
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			rawURL := "https://api.discogs.com/database/search?" + url.Values{"q": {tt.value}}.Encode()
+			if _, err := client.Get(rawURL); err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			// The parameter is encoded once as a pair and once more as part of the base string
+			want := "q%3D" + strings.ReplaceAll(tt.want, "%", "%25")
+			if base := signer.last(); !strings.Contains(base, want) {
+				t.Errorf("base string %q does not contain %q", base, want)
+			}
+		})
+	}
+}
+
+func TestOtherHostsAreNotSigned(t *testing.T) {
+	client, _, sent := newSigningClient("api.discogs.com", nil)
+
+	if _, err := client.Get("https://i.discogs.com/thumb.jpeg"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if auth := (*sent)[0].Header.Get("Authorization"); auth != "" {
+		t.Errorf("Authorization = %q, want image requests to be sent without the API tokens", auth)
+	}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// standIn is an httptest stand-in for both the Discogs API and its authorize page
+type standIn struct {
+	*httptest.Server
+	callbackURL string
+
+	mu   sync.Mutex
+	hits map[string]int
+}
+
+func newStandIn(t *testing.T, callbackURL string) *standIn {
+	s := &standIn{callbackURL: callbackURL, hits: make(map[string]int)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/request_token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		fmt.Fprint(w, "oauth_token=request-token&oauth_token_secret=request-secret&oauth_callback_confirmed=true")
+	})
+	mux.HandleFunc("/oauth/authorize", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("oauth_token") != "request-token" {
+			http.Error(w, "unknown request token", http.StatusBadRequest)
+			return
+		}
+		// The user grants access and Discogs redirects back to the app
+		http.Redirect(w, r, s.callbackURL+"?oauth_token=request-token&oauth_verifier=verifier", http.StatusFound)
+	})
+	mux.HandleFunc("/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		fmt.Fprint(w, "oauth_token=access-token&oauth_token_secret=access-secret")
+	})
+	mux.HandleFunc("/oauth/identity", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "username": "tester"}`)
+	})
+	mux.HandleFunc("/users/tester/collection/fields", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"fields": [{"id": 1, "name": "Media Condition", "type": "dropdown", "options": ["Mint (M)"], "position": 1, "public": true}]}`)
+	})
+	mux.HandleFunc("/users/tester/collection/folders/0/releases", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"pagination": {"page": 1, "pages": 1, "per_page": 100, "items": 1, "urls": {}},
+			"releases": [{
+				"id": 42, "instance_id": 7, "folder_id": 1, "rating": 4,
+				"notes": [{"field_id": 1, "value": "Mint (M)"}],
+				"basic_information": {
+					"id": 42, "title": "Stand-in", "year": 1999,
+					"artists": [{"id": 3, "name": "Artist"}],
+					"labels": [{"id": 5, "name": "Label", "catno": "CAT 1"}]
+				}
+			}]
+		}`)
+	})
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.hits[r.URL.Path]++
+		s.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *standIn) Hits() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hits
+}
+
+// guardTransport fails every request that leaves the allowed hosts
+type guardTransport struct {
+	http.RoundTripper
+	allowed map[string]bool
+
+	mu      sync.Mutex
+	foreign []string
+}
+
+func (g *guardTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !g.allowed[req.URL.Host] {
+		g.mu.Lock()
+		g.foreign = append(g.foreign, req.URL.String())
+		g.mu.Unlock()
+		return nil, fmt.Errorf("request to %s left the stand-in", req.URL)
+	}
+	return g.RoundTripper.RoundTrip(req)
+}
+
+// freePort returns a local port that is free right now
+func freePort(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("finding a free port: %v", err)
+	}
+	defer listener.Close()
+	return strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestClientAgainstStandIn(t *testing.T) {
+	port := freePort(t)
+	callbackURL := "http://localhost:" + port
+	api := newStandIn(t, callbackURL)
+	apiURL, _ := url.Parse(api.URL)
+
+	t.Setenv("DISCOGS_API_CONSUMER_KEY", "consumer-key")
+	t.Setenv("DISCOGS_API_CONSUMER_SECRET", "consumer-secret")
+	t.Setenv("DISCOGS_API_BASE_URL", api.URL)
+	t.Setenv("DISCOGS_AUTHORIZE_URL", api.URL+"/oauth/authorize")
+	t.Setenv("LOCAL_PORT", port)
+	// Keep the saved tokens out of the real config dir
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	guard := &guardTransport{
+		RoundTripper: http.DefaultTransport,
+		allowed:      map[string]bool{apiURL.Host: true, "localhost:" + port: true},
+	}
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = guard
+	t.Cleanup(func() { http.DefaultTransport = defaultTransport })
+
+	// Play the user: follow the authorize page, which redirects to the app's callback
+	browser := openBrowser
+	openBrowser = func(authorizeURL string) error {
+		go func() {
+			// The callback server starts in the background, give it a moment
+			for range 50 {
+				resp, err := http.Get(authorizeURL)
+				if err == nil {
+					resp.Body.Close()
+					return
+				}
+				time.Sleep(20 * time.Millisecond)
+			}
+		}()
+		return nil
+	}
+	t.Cleanup(func() { openBrowser = browser })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	c, err := NewWithContext(ctx, Options{})
+	if err != nil {
+		t.Fatalf("NewWithContext: %v", err)
+	}
+	if c.Identity.Username != "tester" {
+		t.Errorf("Identity.Username = %q, want tester", c.Identity.Username)
+	}
+
+	releases, err := c.GetCollectionWithContext(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetCollectionWithContext: %v", err)
+	}
+	if len(releases) != 1 || releases[0].ReleaseId != 42 || releases[0].Field(1) != "Mint (M)" {
+		t.Errorf("releases = %+v, want the stand-in release with its media condition", releases)
+	}
+
+	hits := api.Hits()
+	for _, path := range []string{
+		"/oauth/request_token",
+		"/oauth/authorize",
+		"/oauth/access_token",
+		"/oauth/identity",
+		"/users/tester/collection/fields",
+		"/users/tester/collection/folders/0/releases",
+	} {
+		if hits[path] == 0 {
+			t.Errorf("%s never reached the stand-in, hits = %v", path, hits)
+		}
+	}
+	if len(guard.foreign) > 0 {
+		t.Errorf("requests left the stand-in: %v", guard.foreign)
+	}
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// InventoryPath is the API path for the user's marketplace inventory.
+	InventoryPath string = "/users/%s/inventory"
+	// ListingsPath is the API path for creating marketplace listings.
+	ListingsPath string = "/marketplace/listings"
+	// ListingPath is the API path for a single marketplace listing.
+	ListingPath string = "/marketplace/listings/%d"
+)
+
+var (
+	ErrInvalidListingStatus = errors.New("listings can only be saved as For Sale or Draft")
+	ErrInvalidCondition     = errors.New("invalid media condition")
+	ErrInvalidSleeve        = errors.New("invalid sleeve condition")
+	ErrInvalidPrice         = errors.New("price must be greater than 0")
+)
+
+// InventoryQuery filters and sorts the user's inventory. Empty fields are not sent.
+type InventoryQuery struct {
+	Page   int
+	Status string
+	// Sort is one of listed, price, item, artist, label, catno, audio, status or location
+	Sort string
+	// SortOrder is asc or desc
+	SortOrder string
+}
+
+// InventorySorts are the sort keys accepted by the inventory endpoint.
+var InventorySorts = []string{"listed", "price", "item", "artist", "label", "catno", "audio", "status", "location"}
+
+// EditableListingStatuses are the statuses a listing can be created or edited with.
+var EditableListingStatuses = []string{dto.ListingStatusForSale, dto.ListingStatusDraft}
+
+// ListingUpdate holds the fields of a listing to create or edit.
+type ListingUpdate struct {
+	ReleaseId       int     `json:"release_id"`
+	Condition       string  `json:"condition"`
+	SleeveCondition string  `json:"sleeve_condition,omitempty"`
+	Price           float64 `json:"price"`
+	Comments        string  `json:"comments,omitempty"`
+	AllowOffers     bool    `json:"allow_offers"`
+	Status          string  `json:"status"`
+	Location        string  `json:"location,omitempty"`
+}
+
+// ListingUpdateFrom returns the update that saves a listing unchanged.
+func ListingUpdateFrom(listing dto.ListingModel) ListingUpdate {
+	return ListingUpdate{
+		ReleaseId:       listing.ReleaseId,
+		Condition:       listing.Condition,
+		SleeveCondition: listing.SleeveCondition,
+		Price:           listing.Price.Value,
+		Comments:        listing.Comments,
+		AllowOffers:     listing.AllowOffers,
+		Status:          listing.Status,
+		Location:        listing.Location,
+	}
+}
+
+// ValidateListing checks a listing update before it is sent to the API.
+func ValidateListing(update ListingUpdate) error {
+	switch {
+	case update.ReleaseId <= 0:
+		return errors.New("release ID is required")
+	case !slices.Contains(dto.Conditions, update.Condition):
+		return fmt.Errorf("%w: %q", ErrInvalidCondition, update.Condition)
+	case update.SleeveCondition != "" && !slices.Contains(dto.SleeveConditions, update.SleeveCondition):
+		return fmt.Errorf("%w: %q", ErrInvalidSleeve, update.SleeveCondition)
+	case update.Price <= 0:
+		return ErrInvalidPrice
+	case !slices.Contains(EditableListingStatuses, update.Status):
+		return fmt.Errorf("%w: %q", ErrInvalidListingStatus, update.Status)
+	}
+	return nil
+}
+
+// GetInventory fetches one page of the user's inventory.
+func (c *DiscogsClient) GetInventory(ctx context.Context, query InventoryQuery) (dto.InventoryModel, error) {
+	params := url.Values{}
+	if query.Status != "" {
+		params.Set("status", query.Status)
+	}
+	if query.Sort != "" {
+		params.Set("sort", query.Sort)
+	}
+	if query.SortOrder != "" {
+		params.Set("sort_order", query.SortOrder)
+	}
+
+	page, err := pageURL(c.apiURL(InventoryPath, c.Identity.Username)+"?"+params.Encode(), max(query.Page, 1))
+	if err != nil {
+		return dto.InventoryModel{}, err
+	}
+
+	var inventory dto.InventoryBaseDto
+	if err := c.getJSON(ctx, page, &inventory); err != nil {
+		return dto.InventoryModel{}, fmt.Errorf("failed to fetch inventory: %w", err)
+	}
+	return dto.MapInventory(inventory)
+}
+
+// CreateListing validates and creates a marketplace listing and returns its ID.
+func (c *DiscogsClient) CreateListing(ctx context.Context, update ListingUpdate) (int, error) {
+	if err := ValidateListing(update); err != nil {
+		return 0, err
+	}
+
+	var created struct {
+		ListingId int `json:"listing_id"`
+	}
+	if err := c.doJSON(ctx, "POST", c.apiURL(ListingsPath), update, &created); err != nil {
+		return 0, fmt.Errorf("failed to create listing for release %d: %w", update.ReleaseId, err)
+	}
+	return created.ListingId, nil
+}
+
+// EditListing validates and saves a marketplace listing.
+func (c *DiscogsClient) EditListing(ctx context.Context, listingId int, update ListingUpdate) error {
+	if err := ValidateListing(update); err != nil {
+		return err
+	}
+	if err := c.doJSON(ctx, "POST", c.apiURL(ListingPath, listingId), update, nil); err != nil {
+		return fmt.Errorf("failed to edit listing %d: %w", listingId, err)
+	}
+	return nil
+}
+
+// DeleteListing removes a listing from the marketplace.
+func (c *DiscogsClient) DeleteListing(ctx context.Context, listingId int) error {
+	if err := c.doJSON(ctx, "DELETE", c.apiURL(ListingPath, listingId), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete listing %d: %w", listingId, err)
+	}
+	return nil
+}
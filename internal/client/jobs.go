@@ -0,0 +1,214 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// ExportsPath is the API path for inventory exports.
+	ExportsPath string = "/inventory/export"
+	// ExportPath is the API path for a single inventory export.
+	ExportPath string = "/inventory/export/%d"
+	// ExportDownloadPath is the API path for the CSV of a finished inventory export.
+	ExportDownloadPath string = "/inventory/export/%d/download"
+	// UploadsPath is the API path for inventory CSV uploads.
+	UploadsPath string = "/inventory/upload"
+	// UploadPath is the API path for uploading an inventory CSV of the given kind.
+	UploadPath string = "/inventory/upload/%s"
+	// UploadJobPath is the API path for a single inventory upload.
+	UploadJobPath string = "/inventory/upload/%d"
+)
+
+// UploadKinds are the kinds of inventory CSV uploads.
+var UploadKinds = []string{dto.JobKindAdd, dto.JobKindChange, dto.JobKindDelete}
+
+var (
+	ErrInvalidUploadKind = errors.New("upload kind must be add, change or delete")
+	ErrJobFailed         = errors.New("inventory job failed")
+)
+
+// createJob sends a request that starts an inventory job and returns the job ID
+// from the Location header of the response.
+func (c *DiscogsClient) createJob(req *http.Request) (int, error) {
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error at %s: %w", req.Method, err)
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.Atoi(path.Base(resp.Header.Get("Location")))
+	if err != nil {
+		return 0, fmt.Errorf("no job ID in response: %w", err)
+	}
+	return id, nil
+}
+
+// RequestExport asks Discogs to export the inventory to CSV and returns the export ID.
+func (c *DiscogsClient) RequestExport(ctx context.Context) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL(ExportsPath), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	id, err := c.createJob(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to request inventory export: %w", err)
+	}
+	return id, nil
+}
+
+// GetExports fetches the recent inventory exports.
+func (c *DiscogsClient) GetExports(ctx context.Context) ([]dto.InventoryJobModel, error) {
+	var exports dto.InventoryJobsBaseDto
+	if err := c.getJSON(ctx, c.apiURL(ExportsPath), &exports); err != nil {
+		return nil, fmt.Errorf("failed to fetch exports: %w", err)
+	}
+	return dto.MapInventoryJobs(exports.Items, dto.JobKindExport)
+}
+
+// GetExport fetches the status of an inventory export.
+func (c *DiscogsClient) GetExport(ctx context.Context, id int) (dto.InventoryJobModel, error) {
+	var export dto.InventoryJobDto
+	if err := c.getJSON(ctx, c.apiURL(ExportPath, id), &export); err != nil {
+		return dto.InventoryJobModel{}, fmt.Errorf("failed to fetch export %d: %w", id, err)
+	}
+	return dto.MapInventoryJob(export, dto.JobKindExport), nil
+}
+
+// DownloadExport saves the CSV of a finished export into dir and returns the file path.
+func (c *DiscogsClient) DownloadExport(ctx context.Context, export dto.InventoryJobModel, dir string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.apiURL(ExportDownloadPath, export.Id), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error at GET: %w", err)
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return "", fmt.Errorf("failed to download export %d: %w", export.Id, err)
+	}
+
+	filename := filepath.Base(export.Filename)
+	if export.Filename == "" {
+		filename = fmt.Sprintf("inventory-export-%d.csv", export.Id)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	target := filepath.Join(dir, filename)
+	// Download next to the target so a failed download leaves no truncated CSV behind
+	file, err := os.CreateTemp(dir, filename+".*.part")
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", target, err)
+	}
+	_, err = io.Copy(file, resp.Body)
+	err = errors.Join(err, file.Close())
+	if err == nil {
+		err = os.Rename(file.Name(), target)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	return target, nil
+}
+
+// UploadInventory uploads a CSV file to add, change or delete listings and returns the upload ID.
+func (c *DiscogsClient) UploadInventory(ctx context.Context, kind, csvPath string) (int, error) {
+	if !slices.Contains(UploadKinds, kind) {
+		return 0, ErrInvalidUploadKind
+	}
+
+	content, err := os.ReadFile(csvPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", csvPath, err)
+	}
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("upload", filepath.Base(csvPath))
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode upload: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return 0, fmt.Errorf("failed to encode upload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return 0, fmt.Errorf("failed to encode upload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL(UploadPath, kind), &body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	id, err := c.createJob(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to upload %s: %w", filepath.Base(csvPath), err)
+	}
+	return id, nil
+}
+
+// GetUploads fetches the recent inventory uploads.
+func (c *DiscogsClient) GetUploads(ctx context.Context) ([]dto.InventoryJobModel, error) {
+	var uploads dto.InventoryJobsBaseDto
+	if err := c.getJSON(ctx, c.apiURL(UploadsPath), &uploads); err != nil {
+		return nil, fmt.Errorf("failed to fetch uploads: %w", err)
+	}
+	return dto.MapInventoryJobs(uploads.Items, "")
+}
+
+// GetUpload fetches the status of an inventory upload.
+func (c *DiscogsClient) GetUpload(ctx context.Context, id int) (dto.InventoryJobModel, error) {
+	var upload dto.InventoryJobDto
+	if err := c.getJSON(ctx, c.apiURL(UploadJobPath, id), &upload); err != nil {
+		return dto.InventoryJobModel{}, fmt.Errorf("failed to fetch upload %d: %w", id, err)
+	}
+	return dto.MapInventoryJob(upload, ""), nil
+}
+
+// PollJob fetches a job every interval until it is done, reporting each status to update.
+// It returns ErrJobFailed along with the job if Discogs could not process it.
+func PollJob(ctx context.Context, interval time.Duration, fetch func(context.Context) (dto.InventoryJobModel, error), update func(dto.InventoryJobModel)) (dto.InventoryJobModel, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		job, err := fetch(ctx)
+		if err != nil {
+			return job, err
+		}
+		if update != nil {
+			update(job)
+		}
+		if job.Done() {
+			if job.Status == dto.JobStatusFailure {
+				return job, fmt.Errorf("%w: %s", ErrJobFailed, job.Results)
+			}
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
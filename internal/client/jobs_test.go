@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+func TestDownloadExport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("listing_id,price\n1,9.99\n"))
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+
+	target, err := newTestClient(srv).DownloadExport(context.Background(), dto.InventoryJobModel{Id: 7, Filename: "export.csv"}, dir)
+	if err != nil {
+		t.Fatalf("DownloadExport: %v", err)
+	}
+	content, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatalf("reading %s: %v", target, err)
+	}
+	if target != filepath.Join(dir, "export.csv") || string(content) != "listing_id,price\n1,9.99\n" {
+		t.Errorf("saved %q to %s, want the CSV in export.csv", content, target)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("dir holds %d files, want only the export", len(entries))
+	}
+}
+
+func TestDownloadExportRemovesPartialFile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The connection closes before the announced length was sent
+		w.Header().Set("Content-Length", "1000")
+		w.Write([]byte("listing_id,price\n1,9."))
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+
+	_, err := newTestClient(srv).DownloadExport(context.Background(), dto.InventoryJobModel{Id: 7, Filename: "export.csv"}, dir)
+	if err == nil {
+		t.Fatal("DownloadExport succeeded, want the truncated download to fail")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("dir holds %v, want the partial file to be removed", entries)
+	}
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// UserListsPath is the API path for the lists of a user.
+	UserListsPath string = "/users/%s/lists"
+	// ListPath is the API path for a single list and its items.
+	ListPath string = "/lists/%d"
+)
+
+// GetLists fetches every page of the user's lists, including private ones.
+func (c *DiscogsClient) GetLists(ctx context.Context, progress ProgressFunc) ([]dto.ListSummaryModel, error) {
+	lists, err := fetchAllPages(ctx, c, c.apiURL(UserListsPath, c.Identity.Username), progress,
+		func(page *dto.ListsBaseDto) (dto.DiscogsPaginationDto, []dto.UserListDto) {
+			return page.Pagination, page.Lists
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch lists: %w", err)
+	}
+	return dto.MapLists(lists)
+}
+
+// GetList fetches a list with its items. Lists of other users must be public.
+func (c *DiscogsClient) GetList(ctx context.Context, id int) (dto.ListModel, error) {
+	var list dto.ListDto
+	if err := c.getJSON(ctx, c.apiURL(ListPath, id), &list); err != nil {
+		return dto.ListModel{}, fmt.Errorf("failed to fetch list %d: %w", id, err)
+	}
+	return dto.MapList(list)
+}
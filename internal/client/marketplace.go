@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// UserPath is the API path for the profile of a user.
+	UserPath string = "/users/%s"
+	// MarketplaceStatsPath is the API path for the marketplace statistics of a release.
+	MarketplaceStatsPath string = "/marketplace/stats/%d"
+	// PriceSuggestionsPath is the API path for the suggested prices of a release by condition.
+	PriceSuggestionsPath string = "/marketplace/price_suggestions/%d"
+)
+
+// GetCurrency fetches the user's marketplace currency once and caches it.
+func (c *DiscogsClient) GetCurrency(ctx context.Context) (string, error) {
+	c.currencyMu.Lock()
+	defer c.currencyMu.Unlock()
+	if c.currency != "" {
+		return c.currency, nil
+	}
+
+	var profile struct {
+		CurrAbbr string `json:"curr_abbr"`
+	}
+	if err := c.getJSON(ctx, c.apiURL(UserPath, c.Identity.Username), &profile); err != nil {
+		return "", fmt.Errorf("failed to fetch profile: %w", err)
+	}
+	c.currency = profile.CurrAbbr
+	return c.currency, nil
+}
+
+// GetReleaseStats fetches the lowest price and number for sale of a release in the user's currency.
+func (c *DiscogsClient) GetReleaseStats(ctx context.Context, releaseId int) (dto.ReleaseStatsModel, error) {
+	statsURL := c.apiURL(MarketplaceStatsPath, releaseId)
+	// Without a currency Discogs answers in USD, which is still better than nothing
+	if currency, err := c.GetCurrency(ctx); err == nil && currency != "" {
+		statsURL += "?" + url.Values{"curr_abbr": {currency}}.Encode()
+	}
+
+	var stats dto.MarketplaceStatsDto
+	if err := c.getJSON(ctx, statsURL, &stats); err != nil {
+		return dto.ReleaseStatsModel{}, fmt.Errorf("failed to fetch marketplace stats of release %d: %w", releaseId, err)
+	}
+	return dto.MapReleaseStats(stats)
+}
+
+// GetPriceSuggestions fetches the suggested prices of a release by condition in the seller's currency.
+// Discogs only answers this for users with seller settings.
+func (c *DiscogsClient) GetPriceSuggestions(ctx context.Context, releaseId int) (dto.PriceSuggestionsModel, error) {
+	var suggestions dto.PriceSuggestionsDto
+	if err := c.getJSON(ctx, c.apiURL(PriceSuggestionsPath, releaseId), &suggestions); err != nil {
+		return nil, fmt.Errorf("failed to fetch price suggestions of release %d: %w", releaseId, err)
+	}
+	return dto.MapPriceSuggestions(suggestions)
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// OrderPath is the API path for a single marketplace order.
+	OrderPath string = "/marketplace/orders/%s"
+	// OrderMessagesPath is the API path for the message thread of an order.
+	OrderMessagesPath string = "/marketplace/orders/%s/messages"
+)
+
+var (
+	ErrInvalidOrderTransition = errors.New("invalid order status transition")
+	ErrShippingLocked         = errors.New("shipping can no longer be changed for this order")
+)
+
+// cancelledStatuses are reachable from every status before shipping.
+var cancelledStatuses = []string{
+	dto.OrderStatusCancelledNonPay,
+	dto.OrderStatusCancelledNoItem,
+	dto.OrderStatusCancelledPerBuyer,
+}
+
+// orderTransitions lists the statuses a seller may move an order to from each status.
+var orderTransitions = map[string][]string{
+	dto.OrderStatusNewOrder: append([]string{
+		dto.OrderStatusBuyerContacted,
+		dto.OrderStatusInvoiceSent,
+		dto.OrderStatusPaymentPending,
+		dto.OrderStatusPaymentReceived,
+		dto.OrderStatusInProgress,
+		dto.OrderStatusShipped,
+	}, cancelledStatuses...),
+	dto.OrderStatusBuyerContacted: append([]string{
+		dto.OrderStatusInvoiceSent,
+		dto.OrderStatusPaymentPending,
+		dto.OrderStatusPaymentReceived,
+		dto.OrderStatusInProgress,
+		dto.OrderStatusShipped,
+	}, cancelledStatuses...),
+	dto.OrderStatusInvoiceSent: append([]string{
+		dto.OrderStatusPaymentPending,
+		dto.OrderStatusPaymentReceived,
+		dto.OrderStatusInProgress,
+		dto.OrderStatusShipped,
+	}, cancelledStatuses...),
+	dto.OrderStatusPaymentPending: append([]string{
+		dto.OrderStatusPaymentReceived,
+		dto.OrderStatusInProgress,
+		dto.OrderStatusShipped,
+	}, cancelledStatuses...),
+	dto.OrderStatusPaymentReceived: append([]string{
+		dto.OrderStatusInProgress,
+		dto.OrderStatusShipped,
+		dto.OrderStatusRefundSent,
+	}, cancelledStatuses...),
+	dto.OrderStatusInProgress: append([]string{
+		dto.OrderStatusShipped,
+		dto.OrderStatusRefundSent,
+	}, cancelledStatuses...),
+	dto.OrderStatusShipped: {
+		dto.OrderStatusRefundSent,
+	},
+}
+
+// shippingEditableStatuses are the statuses in which the shipping cost may still change.
+var shippingEditableStatuses = []string{
+	dto.OrderStatusNewOrder,
+	dto.OrderStatusBuyerContacted,
+	dto.OrderStatusInvoiceSent,
+	dto.OrderStatusPaymentPending,
+}
+
+// OrderUpdate describes the changes to apply to an order. Zero fields are left untouched.
+type OrderUpdate struct {
+	Status   string
+	Shipping *float64
+}
+
+// AllowedOrderTransitions returns the statuses an order can be moved to. When
+// the API reported next_status for the order only statuses allowed by both
+// the API and the local transition table are returned.
+func AllowedOrderTransitions(order dto.OrderModel) []string {
+	allowed := make([]string, 0, len(orderTransitions[order.Status]))
+	for _, status := range orderTransitions[order.Status] {
+		if len(order.NextStatus) == 0 || slices.Contains(order.NextStatus, status) {
+			allowed = append(allowed, status)
+		}
+	}
+	return allowed
+}
+
+// CanEditShipping reports whether the shipping cost of an order may still change.
+func CanEditShipping(order dto.OrderModel) bool {
+	return slices.Contains(shippingEditableStatuses, order.Status)
+}
+
+// ValidateOrderUpdate rejects updates the API would refuse.
+func ValidateOrderUpdate(order dto.OrderModel, update OrderUpdate) error {
+	if update.Status != "" && !slices.Contains(AllowedOrderTransitions(order), update.Status) {
+		return fmt.Errorf("%w: %q to %q", ErrInvalidOrderTransition, order.Status, update.Status)
+	}
+	if update.Shipping != nil {
+		if !CanEditShipping(order) {
+			return fmt.Errorf("%w (status %q)", ErrShippingLocked, order.Status)
+		}
+		if *update.Shipping < 0 {
+			return errors.New("shipping cost cannot be negative")
+		}
+	}
+	return nil
+}
+
+// GetOrder fetches a single marketplace order.
+func (c *DiscogsClient) GetOrder(ctx context.Context, id string) (dto.OrderModel, error) {
+	var order dto.OrderDto
+	if err := c.getJSON(ctx, c.apiURL(OrderPath, url.PathEscape(id)), &order); err != nil {
+		return dto.OrderModel{}, fmt.Errorf("failed to fetch order %s: %w", id, err)
+	}
+
+	orders, err := dto.MapOrders([]dto.OrderDto{order})
+	if err != nil {
+		return dto.OrderModel{}, err
+	}
+	return orders[0], nil
+}
+
+// GetOrderMessages fetches the whole message thread of an order.
+func (c *DiscogsClient) GetOrderMessages(ctx context.Context, id string) ([]dto.OrderMessageModel, error) {
+	messages, err := fetchAllPages(ctx, c, c.apiURL(OrderMessagesPath, url.PathEscape(id)), nil,
+		func(page *dto.OrderMessagesBaseDto) (dto.DiscogsPaginationDto, []dto.OrderMessageDto) {
+			return page.Pagination, page.Messages
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch messages of order %s: %w", id, err)
+	}
+	return dto.MapOrderMessages(messages)
+}
+
+// PostOrderMessage adds a message to the thread of an order.
+func (c *DiscogsClient) PostOrderMessage(ctx context.Context, id, message string) (dto.OrderMessageModel, error) {
+	body := map[string]string{"message": message}
+
+	var created dto.OrderMessageDto
+	if err := c.doJSON(ctx, "POST", c.apiURL(OrderMessagesPath, url.PathEscape(id)), body, &created); err != nil {
+		return dto.OrderMessageModel{}, fmt.Errorf("failed to send message on order %s: %w", id, err)
+	}
+
+	messages, err := dto.MapOrderMessages([]dto.OrderMessageDto{created})
+	if err != nil {
+		return dto.OrderMessageModel{}, err
+	}
+	return messages[0], nil
+}
+
+// UpdateOrder changes the status and/or shipping cost of an order
+// after validating the change against the allowed transitions.
+func (c *DiscogsClient) UpdateOrder(ctx context.Context, order dto.OrderModel, update OrderUpdate) (dto.OrderModel, error) {
+	if err := ValidateOrderUpdate(order, update); err != nil {
+		return dto.OrderModel{}, err
+	}
+
+	body := make(map[string]any)
+	if update.Status != "" {
+		body["status"] = update.Status
+	}
+	if update.Shipping != nil {
+		body["shipping"] = *update.Shipping
+	}
+	if len(body) == 0 {
+		return order, nil
+	}
+
+	var updated dto.OrderDto
+	if err := c.doJSON(ctx, "POST", c.apiURL(OrderPath, url.PathEscape(order.Id)), body, &updated); err != nil {
+		return dto.OrderModel{}, fmt.Errorf("failed to update order %s: %w", order.Id, err)
+	}
+
+	orders, err := dto.MapOrders([]dto.OrderDto{updated})
+	if err != nil {
+		return dto.OrderModel{}, err
+	}
+	return orders[0], nil
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// defaultPerPage is the largest page size accepted by the Discogs API.
+const defaultPerPage = 100
+
+// ProgressFunc is called after every fetched page with the number of items
+// received so far and the total number of items reported by the API.
+type ProgressFunc func(fetched, total int)
+
+// pageURL returns rawURL with the page and per_page query parameters set.
+func pageURL(rawURL string, page int) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	q := u.Query()
+	q.Set("page", strconv.Itoa(page))
+	q.Set("per_page", strconv.Itoa(defaultPerPage))
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// fetchAllPages walks a paginated Discogs list starting at rawURL. Every page
+// is decoded into P and its items are extracted with the items func. The
+// pagination.urls.next link is followed when present, otherwise the page
+// parameter is incremented until pagination.pages is reached.
+func fetchAllPages[P any, I any](ctx context.Context, c *DiscogsClient, rawURL string, progress ProgressFunc, items func(*P) (dto.DiscogsPaginationDto, []I)) ([]I, error) {
+	next, err := pageURL(rawURL, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []I
+	for next != "" {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var page P
+		if err := c.getJSON(ctx, next, &page); err != nil {
+			return nil, err
+		}
+
+		pagination, pageItems := items(&page)
+		if all == nil {
+			all = make([]I, 0, pagination.Items)
+		}
+		all = append(all, pageItems...)
+		if progress != nil {
+			progress(len(all), pagination.Items)
+		}
+
+		if pagination.Page >= pagination.Pages {
+			break
+		}
+		next = pagination.Urls["next"]
+		if next == "" {
+			next, err = pageURL(rawURL, pagination.Page+1)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return all, nil
+}
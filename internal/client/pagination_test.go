@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// newTestClient returns a client talking to srv without OAuth signing or rate limiting
+func newTestClient(srv *httptest.Server) *DiscogsClient {
+	return &DiscogsClient{
+		Client:      srv.Client(),
+		baseURL:     srv.URL,
+		Identity:    DiscogsIdentity{Username: "tester"},
+		rateLimiter: NewRateLimiter(defaultRateLimit, defaultRateWindow),
+	}
+}
+
+type testPageDto struct {
+	Pagination dto.DiscogsPaginationDto `json:"pagination"`
+	Items      []int                    `json:"items"`
+}
+
+func testPageItems(page *testPageDto) (dto.DiscogsPaginationDto, []int) {
+	return page.Pagination, page.Items
+}
+
+// pagedServer serves pages of perPage items. With next set every page
+// links the next one through pagination.urls.next using a cursor parameter.
+type pagedServer struct {
+	pages   int
+	perPage int
+	next    bool
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func (s *pagedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r)
+	s.mu.Unlock()
+
+	param := r.URL.Query().Get("page")
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		param = cursor
+	}
+	page, err := strconv.Atoi(param)
+	if err != nil || page < 1 || page > s.pages {
+		http.Error(w, "bad page", http.StatusBadRequest)
+		return
+	}
+
+	body := testPageDto{
+		Pagination: dto.DiscogsPaginationDto{
+			Page:  page,
+			Pages: s.pages,
+			Per:   s.perPage,
+			Items: s.pages * s.perPage,
+			Urls:  map[string]string{},
+		},
+	}
+	for i := range s.perPage {
+		body.Items = append(body.Items, (page-1)*s.perPage+i)
+	}
+	if s.next && page < s.pages {
+		body.Pagination.Urls["next"] = fmt.Sprintf("http://%s/items?cursor=%d", r.Host, page+1)
+	}
+	json.NewEncoder(w).Encode(body)
+}
+
+func (s *pagedServer) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func TestFetchAllPages(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages int
+		next  bool
+		check func(t *testing.T, requests []*http.Request)
+	}{
+		{
+			name:  "follows pagination.urls.next",
+			pages: 3,
+			next:  true,
+			check: func(t *testing.T, requests []*http.Request) {
+				for i, req := range requests[1:] {
+					if got := req.URL.Query().Get("cursor"); got != strconv.Itoa(i+2) {
+						t.Errorf("request %d: cursor = %q, want the next link to be followed", i+2, got)
+					}
+				}
+			},
+		},
+		{
+			name:  "falls back to page and per_page",
+			pages: 3,
+			check: func(t *testing.T, requests []*http.Request) {
+				for i, req := range requests {
+					q := req.URL.Query()
+					if q.Get("page") != strconv.Itoa(i+1) || q.Get("per_page") != "100" {
+						t.Errorf("request %d: query = %q, want page=%d&per_page=100", i+1, req.URL.RawQuery, i+1)
+					}
+				}
+			},
+		},
+		{
+			name:  "single page",
+			pages: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &pagedServer{pages: tt.pages, perPage: 2, next: tt.next}
+			srv := httptest.NewServer(handler)
+			defer srv.Close()
+			c := newTestClient(srv)
+
+			var progress [][2]int
+			items, err := fetchAllPages(context.Background(), c, srv.URL+"/items", func(fetched, total int) {
+				progress = append(progress, [2]int{fetched, total})
+			}, testPageItems)
+			if err != nil {
+				t.Fatalf("fetchAllPages: %v", err)
+			}
+
+			if len(items) != tt.pages*2 {
+				t.Fatalf("got %d items, want %d", len(items), tt.pages*2)
+			}
+			for i, item := range items {
+				if item != i {
+					t.Fatalf("items = %v, want them in page order", items)
+				}
+			}
+			requests := handler.Requests()
+			if len(requests) != tt.pages {
+				t.Errorf("got %d requests, want %d", len(requests), tt.pages)
+			}
+			if len(progress) != tt.pages {
+				t.Fatalf("got %d progress calls, want one per page", len(progress))
+			}
+			for i, p := range progress {
+				if p != [2]int{(i + 1) * 2, tt.pages * 2} {
+					t.Errorf("progress call %d = %v, want [%d %d]", i+1, p, (i+1)*2, tt.pages*2)
+				}
+			}
+			if tt.check != nil {
+				tt.check(t, requests)
+			}
+		})
+	}
+}
+
+func TestFetchAllPagesStopsOnCancel(t *testing.T) {
+	handler := &pagedServer{pages: 5, perPage: 2}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	c := newTestClient(srv)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := fetchAllPages(ctx, c, srv.URL+"/items", func(fetched, _ int) {
+		if fetched >= 4 {
+			cancel()
+		}
+	}, testPageItems)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if got := len(handler.Requests()); got != 2 {
+		t.Errorf("got %d requests, want the walk to stop after 2", got)
+	}
+}
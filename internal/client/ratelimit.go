@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultRateLimit is the authenticated Discogs budget in requests per window.
+	defaultRateLimit = 60
+	// defaultRateWindow is the moving window the Discogs budget applies to.
+	defaultRateWindow = time.Minute
+)
+
+// RateLimitStatus is a snapshot of the Discogs request budget as reported by
+// the X-Discogs-Ratelimit headers of the latest API response.
+type RateLimitStatus struct {
+	Limit     int
+	Used      int
+	Remaining int
+	UpdatedAt time.Time
+}
+
+// RateLimiter is a token bucket shared by every request made through a
+// DiscogsClient. The bucket refills Limit tokens per window and is corrected
+// with the budget the API reports back in its response headers.
+type RateLimiter struct {
+	mu     sync.Mutex
+	window time.Duration
+	tokens float64
+	last   time.Time
+	status RateLimitStatus
+}
+
+// NewRateLimiter returns a full bucket allowing limit requests per window.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		window: window,
+		tokens: float64(limit),
+		last:   time.Now(),
+		status: RateLimitStatus{Limit: limit, Remaining: limit},
+	}
+}
+
+// refill adds the tokens accumulated since the last call. Must hold l.mu.
+func (l *RateLimiter) refill(now time.Time) {
+	limit := float64(l.status.Limit)
+	l.tokens += now.Sub(l.last).Seconds() * limit / l.window.Seconds()
+	if l.tokens > limit {
+		l.tokens = limit
+	}
+	l.last = now
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		l.refill(time.Now())
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		perToken := l.window.Seconds() / float64(l.status.Limit)
+		delay := time.Duration((1 - l.tokens) * perToken * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reservation is budget taken ahead of time by Reserve
+type reservation struct {
+	tokens atomic.Int64
+}
+
+type reservationKey struct{}
+
+// take uses one reserved token if any is left
+func (r *reservation) take() bool {
+	return r.tokens.Add(-1) >= 0
+}
+
+// Reserve blocks until n requests may be sent with reserve tokens left for others, or
+// ctx is done, and takes the n tokens at once. Requests made with the returned context
+// use the taken tokens before waiting for new ones. Batch jobs use it to leave budget
+// for interactive requests.
+func (l *RateLimiter) Reserve(ctx context.Context, n, reserve int) (context.Context, error) {
+	if n <= 0 {
+		return ctx, nil
+	}
+	for {
+		l.mu.Lock()
+		l.refill(time.Now())
+		need := min(float64(n+reserve), float64(l.status.Limit))
+		if l.tokens >= need {
+			l.tokens -= float64(n)
+			l.mu.Unlock()
+			r := &reservation{}
+			r.tokens.Store(int64(n))
+			return context.WithValue(ctx, reservationKey{}, r), nil
+		}
+		perToken := l.window.Seconds() / float64(l.status.Limit)
+		delay := time.Duration((need - l.tokens) * perToken * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Update corrects the bucket with the X-Discogs-Ratelimit headers of a
+// response. Responses without the headers (e.g. images) are ignored.
+func (l *RateLimiter) Update(header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-Discogs-Ratelimit"))
+	if err != nil || limit <= 0 {
+		return
+	}
+	used, _ := strconv.Atoi(header.Get("X-Discogs-Ratelimit-Used"))
+	remaining, err := strconv.Atoi(header.Get("X-Discogs-Ratelimit-Remaining"))
+	if err != nil {
+		remaining = limit - used
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill(time.Now())
+	l.status = RateLimitStatus{
+		Limit:     limit,
+		Used:      used,
+		Remaining: remaining,
+		UpdatedAt: time.Now(),
+	}
+	// The server knows about requests we did not count (e.g. other apps
+	// using the same token), so never hold more tokens than it allows.
+	if l.tokens > float64(remaining) {
+		l.tokens = float64(remaining)
+	}
+}
+
+// Status returns the most recent budget reported by the API.
+func (l *RateLimiter) Status() RateLimitStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.status
+}
+
+// rateLimitTransport schedules every API request through a shared RateLimiter.
+// Requests to other hosts, e.g. thumbnails from the image CDN, don't count
+// against the API budget and are passed through.
+type rateLimitTransport struct {
+	Transport http.RoundTripper
+	limiter   *RateLimiter
+	apiHost   string
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.apiHost {
+		return t.Transport.RoundTrip(req)
+	}
+	if r, ok := req.Context().Value(reservationKey{}).(*reservation); !ok || !r.take() {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	t.limiter.Update(resp.Header)
+	return resp, nil
+}
+
+// RateLimit returns the current Discogs request budget.
+func (c *DiscogsClient) RateLimit() RateLimitStatus {
+	return c.rateLimiter.Status()
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rateLimitedServer reports the given budget in the X-Discogs-Ratelimit headers
+func rateLimitedServer(limit, used, remaining string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Discogs-Ratelimit", limit)
+		w.Header().Set("X-Discogs-Ratelimit-Used", used)
+		w.Header().Set("X-Discogs-Ratelimit-Remaining", remaining)
+		w.Write([]byte("{}"))
+	}))
+}
+
+func newRateLimitedClient(srv *httptest.Server, limiter *RateLimiter) *http.Client {
+	u, _ := url.Parse(srv.URL)
+	return &http.Client{Transport: &rateLimitTransport{
+		Transport: http.DefaultTransport,
+		limiter:   limiter,
+		apiHost:   u.Host,
+	}}
+}
+
+func get(ctx context.Context, client *http.Client, rawURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func TestRateLimiterFollowsHeaders(t *testing.T) {
+	srv := rateLimitedServer("25", "25", "0")
+	defer srv.Close()
+	limiter := NewRateLimiter(defaultRateLimit, defaultRateWindow)
+	client := newRateLimitedClient(srv, limiter)
+
+	if err := get(context.Background(), client, srv.URL+"/oauth/identity"); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	status := limiter.Status()
+	if status.Limit != 25 || status.Used != 25 || status.Remaining != 0 {
+		t.Errorf("Status() = %+v, want limit 25, used 25, remaining 0", status)
+	}
+	if status.UpdatedAt.IsZero() {
+		t.Error("Status().UpdatedAt is not set")
+	}
+
+	// The bucket is empty, so the next request has to wait for a refill
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() = %v, want it to block until the deadline", err)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := get(ctx, client, srv.URL+"/oauth/identity"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("request with an empty bucket = %v, want it to block until the deadline", err)
+	}
+}
+
+func TestRateLimiterIgnoresResponsesWithoutHeaders(t *testing.T) {
+	limiter := NewRateLimiter(defaultRateLimit, defaultRateWindow)
+	limiter.Update(http.Header{"Content-Type": {"image/jpeg"}})
+
+	status := limiter.Status()
+	if status.Limit != defaultRateLimit || !status.UpdatedAt.IsZero() {
+		t.Errorf("Status() = %+v, want the initial budget", status)
+	}
+}
+
+func TestRateLimitTransportSkipsOtherHosts(t *testing.T) {
+	api := rateLimitedServer("25", "25", "0")
+	defer api.Close()
+	images := rateLimitedServer("25", "1", "24")
+	defer images.Close()
+	limiter := NewRateLimiter(defaultRateLimit, defaultRateWindow)
+	client := newRateLimitedClient(api, limiter)
+
+	if err := get(context.Background(), client, api.URL+"/oauth/identity"); err != nil {
+		t.Fatalf("API request: %v", err)
+	}
+
+	// Thumbnails don't count against the API budget, so they are never held back
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := get(ctx, client, images.URL+"/thumb.jpeg"); err != nil {
+		t.Fatalf("image request: %v", err)
+	}
+	if status := limiter.Status(); status.Remaining != 0 {
+		t.Errorf("Status().Remaining = %d, want image responses to be ignored", status.Remaining)
+	}
+}
+
+func TestRateLimiterReserveTakesTokens(t *testing.T) {
+	limiter := NewRateLimiter(20, time.Minute)
+
+	// Each reservation needs 5 tokens plus 10 left over, so the third one has to wait
+	for i := range 2 {
+		if _, err := limiter.Reserve(context.Background(), 5, 10); err != nil {
+			t.Fatalf("Reserve %d: %v", i+1, err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Reserve(ctx, 5, 10); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("third Reserve = %v, want it to block until the deadline", err)
+	}
+}
+
+func TestRateLimitTransportUsesReservation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+	limiter := NewRateLimiter(2, time.Minute)
+	client := newRateLimitedClient(srv, limiter)
+
+	reserved, err := limiter.Reserve(context.Background(), 2, 0)
+	if err != nil {
+		t.Fatalf("Reserve: %v", err)
+	}
+	// The bucket is empty now, but the reserved requests are already paid for
+	ctx, cancel := context.WithTimeout(reserved, time.Second)
+	defer cancel()
+	for i := range 2 {
+		if err := get(ctx, client, srv.URL+"/marketplace/listings/1"); err != nil {
+			t.Fatalf("reserved request %d: %v", i+1, err)
+		}
+	}
+
+	ctx, cancel = context.WithTimeout(reserved, 100*time.Millisecond)
+	defer cancel()
+	if err := get(ctx, client, srv.URL+"/marketplace/listings/1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("request past the reservation = %v, want it to wait for the bucket", err)
+	}
+}
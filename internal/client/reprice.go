@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// repriceBatchSize is the number of listings edited between two budget checks.
+	repriceBatchSize = 10
+	// repriceReserve is the request budget left for interactive use while repricing.
+	repriceReserve = 10
+)
+
+var (
+	ErrInvalidRepriceRule = errors.New("invalid reprice rule")
+	ErrNoSuggestion       = errors.New("no price suggestion for this condition")
+)
+
+// RepriceRule describes how the new price of a listing is computed. The steps are
+// applied in field order; zero values disable a step.
+type RepriceRule struct {
+	// MatchSuggestion starts from the suggested price for the listing's condition instead of its current price
+	MatchSuggestion bool
+	// Percent changes the price by a percentage, e.g. -10 for a 10% discount
+	Percent float64
+	// Floor and Ceiling bound the new price
+	Floor   float64
+	Ceiling float64
+	// Round99 rounds the price to the nearest .99, staying within the bounds
+	Round99 bool
+}
+
+// Validate checks that the rule can produce a price.
+func (r RepriceRule) Validate() error {
+	switch {
+	case r.Percent <= -100:
+		return fmt.Errorf("%w: percentage must be above -100", ErrInvalidRepriceRule)
+	case r.Floor < 0 || r.Ceiling < 0:
+		return fmt.Errorf("%w: bounds can't be negative", ErrInvalidRepriceRule)
+	case r.Ceiling > 0 && r.Floor > r.Ceiling:
+		return fmt.Errorf("%w: floor is above ceiling", ErrInvalidRepriceRule)
+	case r.Round99 && r.Ceiling > 0 && first99(r.Floor) > r.Ceiling:
+		return fmt.Errorf("%w: no .99 price between floor and ceiling", ErrInvalidRepriceRule)
+	}
+	return nil
+}
+
+// first99 returns the lowest .99 price not below floor, starting at 0.99
+func first99(floor float64) float64 {
+	cents := int(math.Round(floor * 100))
+	cents += (99 - cents%100 + 100) % 100
+	return float64(cents) / 100
+}
+
+// Apply computes the new price of a listing. suggestions is only used with MatchSuggestion.
+func (r RepriceRule) Apply(listing dto.ListingModel, suggestions dto.PriceSuggestionsModel) (float64, error) {
+	price := listing.Price.Value
+	if r.MatchSuggestion {
+		suggestion, ok := suggestions.ForCondition(listing.Condition)
+		if !ok {
+			return 0, ErrNoSuggestion
+		}
+		price = suggestion.Value
+	}
+
+	price *= 1 + r.Percent/100
+	price = r.clamp(price)
+	if r.Round99 {
+		rounded := math.Max(math.Round(price), 1) - 0.01
+		// Rounding may cross a bound, move to the next .99 inside it
+		if r.Floor > 0 && rounded < r.Floor {
+			rounded++
+		}
+		if r.Ceiling > 0 && rounded > r.Ceiling {
+			rounded--
+		}
+		// Without a .99 between the bounds the clamped price is kept
+		if r.clamp(rounded) == rounded {
+			price = rounded
+		}
+	}
+	// Discogs prices have two decimals
+	return math.Round(price*100) / 100, nil
+}
+
+// clamp bounds price by Floor and Ceiling
+func (r RepriceRule) clamp(price float64) float64 {
+	if r.Floor > 0 {
+		price = math.Max(price, r.Floor)
+	}
+	if r.Ceiling > 0 {
+		price = math.Min(price, r.Ceiling)
+	}
+	return price
+}
+
+// PriceChange is the planned new price of a listing. Skipped explains why a listing is left unchanged.
+type PriceChange struct {
+	Listing dto.ListingModel
+	Before  float64
+	After   float64
+	Skipped error
+}
+
+// Changed reports whether the change would update the listing.
+func (p PriceChange) Changed() bool {
+	return p.Skipped == nil && p.After != p.Before
+}
+
+// RepriceResult is the outcome of applying a price change.
+type RepriceResult struct {
+	Change PriceChange
+	Err    error
+}
+
+// PlanReprice computes the new price of each listing without changing anything. Price
+// suggestions are fetched once per release when the rule needs them.
+func (c *DiscogsClient) PlanReprice(ctx context.Context, listings []dto.ListingModel, rule RepriceRule, progress ProgressFunc) ([]PriceChange, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	suggestions := make(map[int]dto.PriceSuggestionsModel)
+	changes := make([]PriceChange, len(listings))
+	for i, listing := range listings {
+		change := PriceChange{Listing: listing, Before: listing.Price.Value, After: listing.Price.Value}
+		switch {
+		case !slices.Contains(EditableListingStatuses, listing.Status):
+			change.Skipped = fmt.Errorf("%w: %s", ErrInvalidListingStatus, listing.Status)
+		default:
+			if _, ok := suggestions[listing.ReleaseId]; rule.MatchSuggestion && !ok {
+				releaseSuggestions, err := c.GetPriceSuggestions(ctx, listing.ReleaseId)
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				if err != nil {
+					// Keep planning, the listing is skipped below
+					releaseSuggestions = dto.PriceSuggestionsModel{}
+				}
+				suggestions[listing.ReleaseId] = releaseSuggestions
+			}
+			change.After, change.Skipped = rule.Apply(listing, suggestions[listing.ReleaseId])
+			if change.Skipped != nil {
+				change.After = change.Before
+			}
+		}
+		changes[i] = change
+		if progress != nil {
+			progress(i+1, len(listings))
+		}
+	}
+	return changes, nil
+}
+
+// ApplyReprice saves the planned prices in batches. Before each batch it takes the budget
+// of the batch's edits from the rate limiter once they can be covered with room left for
+// interactive requests. Skipped and unchanged listings are reported without a request.
+func (c *DiscogsClient) ApplyReprice(ctx context.Context, changes []PriceChange, progress ProgressFunc) []RepriceResult {
+	results := make([]RepriceResult, len(changes))
+	for start := 0; start < len(changes); start += repriceBatchSize {
+		end := min(start+repriceBatchSize, len(changes))
+		edits := 0
+		for _, change := range changes[start:end] {
+			if change.Changed() {
+				edits++
+			}
+		}
+		batchCtx, err := c.rateLimiter.Reserve(ctx, edits, repriceReserve)
+		if err != nil {
+			for i := start; i < len(changes); i++ {
+				results[i] = RepriceResult{Change: changes[i], Err: err}
+			}
+			return results
+		}
+
+		for i := start; i < end; i++ {
+			change := changes[i]
+			results[i] = RepriceResult{Change: change}
+			if change.Changed() {
+				update := ListingUpdateFrom(change.Listing)
+				update.Price = change.After
+				results[i].Err = c.EditListing(batchCtx, change.Listing.Id, update)
+			}
+			if progress != nil {
+				progress(i+1, len(changes))
+			}
+		}
+	}
+	return results
+}
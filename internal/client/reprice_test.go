@@ -0,0 +1,70 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+func TestRepriceRuleApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  RepriceRule
+		price float64
+		want  float64
+	}{
+		{"percent", RepriceRule{Percent: -10}, 20, 18},
+		{"floor", RepriceRule{Percent: -50, Floor: 15}, 20, 15},
+		{"ceiling", RepriceRule{Percent: 50, Ceiling: 25}, 20, 25},
+		{"round to nearest .99", RepriceRule{Round99: true}, 12.40, 11.99},
+		{"round never goes below 0.99", RepriceRule{Round99: true}, 0.2, 0.99},
+		{"round moves above the floor", RepriceRule{Round99: true, Floor: 12.50}, 12.40, 12.99},
+		{"round moves below the ceiling", RepriceRule{Round99: true, Ceiling: 12.50}, 12.60, 11.99},
+		{"round keeps the clamped price without a .99 in bounds", RepriceRule{Round99: true, Floor: 5.10, Ceiling: 5.50}, 5.30, 5.30},
+		{"round keeps the floor without a .99 in bounds", RepriceRule{Round99: true, Floor: 5.10, Ceiling: 5.50}, 3, 5.10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listing := dto.ListingModel{Price: dto.PriceDto{Currency: "EUR", Value: tt.price}}
+			got, err := tt.rule.Apply(listing, nil)
+			if err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Apply(%v) = %v, want %v", tt.price, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepriceRuleValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  RepriceRule
+		valid bool
+	}{
+		{"empty", RepriceRule{}, true},
+		{"percent at -100", RepriceRule{Percent: -100}, false},
+		{"negative floor", RepriceRule{Floor: -1}, false},
+		{"floor above ceiling", RepriceRule{Floor: 10, Ceiling: 5}, false},
+		{"round with a .99 in bounds", RepriceRule{Round99: true, Floor: 5.10, Ceiling: 5.99}, true},
+		{"round with .99 floor", RepriceRule{Round99: true, Floor: 4.99, Ceiling: 5.50}, true},
+		{"round without a .99 in bounds", RepriceRule{Round99: true, Floor: 5.10, Ceiling: 5.50}, false},
+		{"round below 0.99", RepriceRule{Round99: true, Ceiling: 0.50}, false},
+		{"round without ceiling", RepriceRule{Round99: true, Floor: 5.10}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Validate() = %v, want the rule to be valid", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidRepriceRule) {
+				t.Errorf("Validate() = %v, want ErrInvalidRepriceRule", err)
+			}
+		})
+	}
+}
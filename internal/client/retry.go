@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how transient API failures are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// BaseDelay is the backoff before the second attempt, doubled afterwards.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff and the Retry-After header.
+	MaxDelay time.Duration
+	// AttemptTimeout bounds every single attempt, so waiting between
+	// attempts doesn't count against it.
+	AttemptTimeout time.Duration
+}
+
+// DefaultRetryPolicy is used for every zero field of a configured RetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	BaseDelay:      500 * time.Millisecond,
+	MaxDelay:       30 * time.Second,
+	AttemptTimeout: defaultTimeout,
+}
+
+// withDefaults fills zero fields from DefaultRetryPolicy.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = DefaultRetryPolicy.BaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultRetryPolicy.MaxDelay
+	}
+	if p.AttemptTimeout <= 0 {
+		p.AttemptTimeout = DefaultRetryPolicy.AttemptTimeout
+	}
+	return p
+}
+
+// backoff returns the delay before the next attempt. A Retry-After header
+// from the server wins over the jittered exponential backoff. Both are
+// capped at MaxDelay.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(delay, p.MaxDelay)
+		}
+	}
+
+	ceiling := p.BaseDelay << (attempt - 1)
+	if ceiling <= 0 || ceiling > p.MaxDelay {
+		ceiling = p.MaxDelay
+	}
+	// Full jitter keeps concurrent retries from hitting the API in lockstep
+	return time.Duration(rand.Int64N(int64(ceiling)) + 1)
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// isIdempotent reports whether a request can safely be sent more than once.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody
+	}
+	return false
+}
+
+// isRetryable reports whether an attempt failed with a transient error.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, errNoToken)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// cancelBody cancels the deadline of an attempt once its body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// retryTransport retries idempotent requests that fail with a network
+// error, 429 Too Many Requests or a 5xx status.
+type retryTransport struct {
+	Transport http.RoundTripper
+	policy    RetryPolicy
+}
+
+// attempt sends req once, bounded by the policy's AttemptTimeout.
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.policy.AttemptTimeout)
+	resp, err := t.Transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req) {
+		return t.attempt(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.attempt(req)
+		if attempt >= t.policy.MaxAttempts || req.Context().Err() != nil || !isRetryable(resp, err) {
+			return resp, err
+		}
+
+		delay := t.policy.backoff(attempt, resp)
+		if resp != nil {
+			// Drain the body so the connection can be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// sequenceServer answers the n-th request with the n-th handler, repeating the last one
+func sequenceServer(handlers ...http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		handlers[min(n, len(handlers))-1](w, r)
+	}))
+	return srv, &calls
+}
+
+func respond(code int, header ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for i := 0; i+1 < len(header); i += 2 {
+			w.Header().Set(header[i], header[i+1])
+		}
+		w.WriteHeader(code)
+		w.Write([]byte(http.StatusText(code)))
+	}
+}
+
+func newRetryClient(policy RetryPolicy) *http.Client {
+	return &http.Client{Transport: &retryTransport{
+		Transport: http.DefaultTransport,
+		policy:    policy.withDefaults(),
+	}}
+}
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    50 * time.Millisecond,
+}
+
+func TestRetryTransportRetriesTransientFailures(t *testing.T) {
+	srv, calls := sequenceServer(
+		respond(http.StatusBadGateway),
+		respond(http.StatusTooManyRequests, "Retry-After", "60"),
+		respond(http.StatusOK),
+	)
+	defer srv.Close()
+
+	start := time.Now()
+	resp, err := newRetryClient(testRetryPolicy).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("got %d attempts, want 3", got)
+	}
+	// Retry-After: 60 must be capped at MaxDelay
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("retries took %v, want Retry-After to be capped at %v", elapsed, testRetryPolicy.MaxDelay)
+	}
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	srv, calls := sequenceServer(respond(http.StatusServiceUnavailable))
+	defer srv.Close()
+
+	resp, err := newRetryClient(testRetryPolicy).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want the last 503", resp.StatusCode)
+	}
+	if got := calls.Load(); got != int32(testRetryPolicy.MaxAttempts) {
+		t.Errorf("got %d attempts, want %d", got, testRetryPolicy.MaxAttempts)
+	}
+}
+
+func TestRetryTransportDoesNotRetryPost(t *testing.T) {
+	srv, calls := sequenceServer(respond(http.StatusBadGateway), respond(http.StatusOK))
+	defer srv.Close()
+
+	resp, err := newRetryClient(testRetryPolicy).Post(srv.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("status = %d, want the 502 to be returned as is", resp.StatusCode)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d attempts, want POST to be sent once", got)
+	}
+}
+
+func TestRetryTransportTimesOutSingleAttempts(t *testing.T) {
+	srv, calls := sequenceServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		},
+		respond(http.StatusOK),
+	)
+	defer srv.Close()
+
+	policy := testRetryPolicy
+	policy.AttemptTimeout = 100 * time.Millisecond
+	resp, err := newRetryClient(policy).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The deadline of the successful attempt must not expire while reading the body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || string(body) != "OK" {
+		t.Errorf("got %d %q, want 200 OK", resp.StatusCode, body)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("got %d attempts, want the hanging one to be retried", got)
+	}
+}
+
+func TestBackoffCapsRetryAfter(t *testing.T) {
+	policy := testRetryPolicy.withDefaults()
+	resp := &http.Response{Header: http.Header{"Retry-After": {"60"}}}
+	if got := policy.backoff(1, resp); got != policy.MaxDelay {
+		t.Errorf("backoff = %v, want MaxDelay %v", got, policy.MaxDelay)
+	}
+
+	resp.Header.Set("Retry-After", "0")
+	if got := policy.backoff(1, resp); got != 0 {
+		t.Errorf("backoff = %v, want Retry-After: 0 to be honoured", got)
+	}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		if got := policy.backoff(attempt, nil); got <= 0 || got > policy.MaxDelay {
+			t.Errorf("backoff(%d) = %v, want it in (0, %v]", attempt, got, policy.MaxDelay)
+		}
+	}
+}
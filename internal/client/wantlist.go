@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// WantPath is the API path for a single release in the user's wantlist.
+	WantPath string = "/users/%s/wants/%d"
+)
+
+// AddToWantlist adds a release to the user's wantlist and returns the new want.
+func (c *DiscogsClient) AddToWantlist(ctx context.Context, releaseId int) (dto.ReleaseModel, error) {
+	var want dto.DiscogsReleaseDto[string]
+	if err := c.doJSON(ctx, "PUT", c.apiURL(WantPath, c.Identity.Username, releaseId), nil, &want); err != nil {
+		return dto.ReleaseModel{}, fmt.Errorf("failed to add release %d to wantlist: %w", releaseId, err)
+	}
+	wants, err := dto.MapWishlistReleases([]dto.DiscogsReleaseDto[string]{want})
+	if err != nil {
+		return dto.ReleaseModel{}, err
+	}
+	return wants[0], nil
+}
+
+// EditWant updates the notes and rating of a release in the user's wantlist.
+func (c *DiscogsClient) EditWant(ctx context.Context, releaseId int, notes string, rating uint8) error {
+	if rating > MaxRating {
+		return ErrInvalidRating
+	}
+	body := struct {
+		Notes  string `json:"notes"`
+		Rating uint8  `json:"rating"`
+	}{Notes: notes, Rating: rating}
+	if err := c.doJSON(ctx, "POST", c.apiURL(WantPath, c.Identity.Username, releaseId), body, nil); err != nil {
+		return fmt.Errorf("failed to edit release %d in wantlist: %w", releaseId, err)
+	}
+	return nil
+}
+
+// RemoveFromWantlist removes a release from the user's wantlist.
+func (c *DiscogsClient) RemoveFromWantlist(ctx context.Context, releaseId int) error {
+	if err := c.doJSON(ctx, "DELETE", c.apiURL(WantPath, c.Identity.Username, releaseId), nil, nil); err != nil {
+		return fmt.Errorf("failed to remove release %d from wantlist: %w", releaseId, err)
+	}
+	return nil
+}
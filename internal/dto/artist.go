@@ -0,0 +1,127 @@
+package dto
+
+import "strings"
+
+type DiscogsArtistRefDto struct {
+	Id     int    `json:"id"`
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+type DiscogsArtistDto struct {
+	Id             int                   `json:"id"`
+	Name           string                `json:"name"`
+	RealName       string                `json:"realname"`
+	Profile        string                `json:"profile"`
+	Urls           []string              `json:"urls"`
+	NameVariations []string              `json:"namevariations"`
+	Aliases        []DiscogsArtistRefDto `json:"aliases"`
+	Members        []DiscogsArtistRefDto `json:"members"`
+	Groups         []DiscogsArtistRefDto `json:"groups"`
+}
+
+type DiscogsArtistReleaseDto struct {
+	Id          int                    `json:"id"`
+	Type        string                 `json:"type"`
+	MainRelease int                    `json:"main_release"`
+	Title       string                 `json:"title"`
+	Artist      string                 `json:"artist"`
+	Role        string                 `json:"role"`
+	Year        int                    `json:"year"`
+	Format      string                 `json:"format"`
+	Label       string                 `json:"label"`
+	Thumb       string                 `json:"thumb"`
+	Stats       DiscogsVersionStatsDto `json:"stats"`
+}
+
+type ArtistReleasesBaseDto struct {
+	PaginationBaseDto
+	Releases []DiscogsArtistReleaseDto `json:"releases"`
+}
+
+type ArtistRefModel struct {
+	Id     int
+	Name   string
+	Active bool
+}
+
+type ArtistModel struct {
+	Id             int
+	Name           string
+	RealName       string
+	Profile        string
+	Urls           []string
+	NameVariations string
+	Aliases        []ArtistRefModel
+	Members        []ArtistRefModel
+	Groups         []ArtistRefModel
+}
+
+type ArtistReleaseModel struct {
+	Id           int
+	IsMaster     bool
+	MainRelease  int
+	Title        string
+	Artist       string
+	Role         string
+	Year         int
+	Format       string
+	Label        string
+	InCollection bool
+	InWantlist   bool
+}
+
+// ArtistReleasesModel is one page of an artist's discography.
+type ArtistReleasesModel struct {
+	Releases   []ArtistReleaseModel
+	Page       int
+	Pages      int
+	TotalItems int
+}
+
+func mapArtistRefs(refs []DiscogsArtistRefDto) []ArtistRefModel {
+	data := make([]ArtistRefModel, len(refs))
+	for i, ref := range refs {
+		data[i] = ArtistRefModel{Id: ref.Id, Name: ref.Name, Active: ref.Active}
+	}
+	return data
+}
+
+func MapArtist(artist DiscogsArtistDto) (ArtistModel, error) {
+	return ArtistModel{
+		Id:             artist.Id,
+		Name:           artist.Name,
+		RealName:       artist.RealName,
+		Profile:        artist.Profile,
+		Urls:           artist.Urls,
+		NameVariations: strings.Join(artist.NameVariations, ", "),
+		Aliases:        mapArtistRefs(artist.Aliases),
+		Members:        mapArtistRefs(artist.Members),
+		Groups:         mapArtistRefs(artist.Groups),
+	}, nil
+}
+
+func MapArtistReleases(page ArtistReleasesBaseDto) (ArtistReleasesModel, error) {
+	data := ArtistReleasesModel{
+		Releases:   make([]ArtistReleaseModel, len(page.Releases)),
+		Page:       page.Pagination.Page,
+		Pages:      page.Pagination.Pages,
+		TotalItems: page.Pagination.Items,
+	}
+	for i, release := range page.Releases {
+		data.Releases[i] = ArtistReleaseModel{
+			Id:           release.Id,
+			IsMaster:     release.Type == "master",
+			MainRelease:  release.MainRelease,
+			Title:        release.Title,
+			Artist:       release.Artist,
+			Role:         release.Role,
+			Year:         release.Year,
+			Format:       release.Format,
+			Label:        release.Label,
+			InCollection: release.Stats.User.InCollection > 0,
+			InWantlist:   release.Stats.User.InWantlist > 0,
+		}
+	}
+	return data, nil
+}
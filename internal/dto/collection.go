@@ -0,0 +1,171 @@
+package dto
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+type DiscogsFolderDto struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Count       int    `json:"count"`
+	ResourceUrl string `json:"resource_url"`
+}
+
+type FoldersBaseDto struct {
+	Folders []DiscogsFolderDto `json:"folders"`
+}
+
+type DiscogsInstanceDto struct {
+	InstanceId  int    `json:"instance_id"`
+	ResourceUrl string `json:"resource_url"`
+}
+
+type FolderModel struct {
+	Id    int
+	Name  string
+	Count int
+}
+
+func MapFolder(folder DiscogsFolderDto) FolderModel {
+	return FolderModel{Id: folder.Id, Name: folder.Name, Count: folder.Count}
+}
+
+func MapFolders(folders []DiscogsFolderDto) ([]FolderModel, error) {
+	data := make([]FolderModel, len(folders))
+	for i, folder := range folders {
+		data[i] = MapFolder(folder)
+	}
+	return data, nil
+}
+
+// Collection custom field types.
+const (
+	FieldTypeDropdown = "dropdown"
+	FieldTypeTextarea = "textarea"
+)
+
+// Names of the custom fields every Discogs collection starts with.
+const (
+	FieldNameMediaCondition  = "Media Condition"
+	FieldNameSleeveCondition = "Sleeve Condition"
+	FieldNameNotes           = "Notes"
+)
+
+type CollectionFieldDto struct {
+	Id       int      `json:"id"`
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Position int      `json:"position"`
+	Public   bool     `json:"public"`
+	Options  []string `json:"options"`
+	Lines    int      `json:"lines"`
+}
+
+type CollectionFieldsBaseDto struct {
+	Fields []CollectionFieldDto `json:"fields"`
+}
+
+type CollectionFieldModel struct {
+	Id       int
+	Name     string
+	Type     string
+	Position int
+	Public   bool
+	Options  []string
+	Lines    int
+}
+
+// DefaultCollectionFields are the fields Discogs creates for every collection.
+// They are used when the user's fields can't be loaded.
+var DefaultCollectionFields = []CollectionFieldModel{
+	{Id: 1, Name: FieldNameMediaCondition, Type: FieldTypeDropdown, Position: 1},
+	{Id: 2, Name: FieldNameSleeveCondition, Type: FieldTypeDropdown, Position: 2},
+	{Id: 3, Name: FieldNameNotes, Type: FieldTypeTextarea, Position: 3, Lines: 3},
+}
+
+// FieldValueModel is the value of a custom field on a collection release.
+type FieldValueModel struct {
+	FieldId int
+	Name    string
+	Type    string
+	Value   string
+}
+
+func MapCollectionFields(fields []CollectionFieldDto) ([]CollectionFieldModel, error) {
+	data := make([]CollectionFieldModel, len(fields))
+	for i, field := range fields {
+		data[i] = CollectionFieldModel{
+			Id:       field.Id,
+			Name:     field.Name,
+			Type:     field.Type,
+			Position: field.Position,
+			Public:   field.Public,
+			Options:  field.Options,
+			Lines:    field.Lines,
+		}
+	}
+	// Fields are shown in the order the user arranged them on Discogs
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Position < data[j].Position
+	})
+	return data, nil
+}
+
+// SetField stores the value of a custom field and keeps the condition and note shortcuts in sync.
+func (m *ReleaseModel) SetField(field CollectionFieldModel, value string) {
+	found := false
+	for i := range m.Fields {
+		if m.Fields[i].FieldId == field.Id {
+			m.Fields[i].Value = value
+			found = true
+		}
+	}
+	if !found {
+		m.Fields = append(m.Fields, FieldValueModel{FieldId: field.Id, Name: field.Name, Type: field.Type, Value: value})
+	}
+
+	// The well known fields are matched by name and type since users can reorder and recreate them
+	switch {
+	case field.Type == FieldTypeDropdown && strings.EqualFold(field.Name, FieldNameMediaCondition):
+		m.MediaCondition = value
+	case field.Type == FieldTypeDropdown && strings.EqualFold(field.Name, FieldNameSleeveCondition):
+		m.SleeveCondition = value
+	case field.Type == FieldTypeTextarea && strings.EqualFold(field.Name, FieldNameNotes):
+		m.Note = value
+	}
+}
+
+// Field returns the value of a custom field, or an empty string if it isn't set.
+func (m ReleaseModel) Field(fieldId int) string {
+	for _, value := range m.Fields {
+		if value.FieldId == fieldId {
+			return value.Value
+		}
+	}
+	return ""
+}
+
+type CollectionValueDto struct {
+	Minimum string `json:"minimum"`
+	Median  string `json:"median"`
+	Maximum string `json:"maximum"`
+}
+
+// CollectionValueModel is the estimated value of the collection, formatted in the user's currency.
+type CollectionValueModel struct {
+	Minimum   string
+	Median    string
+	Maximum   string
+	FetchedAt time.Time
+}
+
+func MapCollectionValue(value CollectionValueDto, fetchedAt time.Time) (CollectionValueModel, error) {
+	return CollectionValueModel{
+		Minimum:   value.Minimum,
+		Median:    value.Median,
+		Maximum:   value.Maximum,
+		FetchedAt: fetchedAt,
+	}, nil
+}
@@ -84,10 +84,17 @@ type WishlistBaseDto struct {
 }
 type OrdersBaseDto struct {
 	PaginationBaseDto
-	Orders []DiscogsReleaseDto[string] `json:"orders"`
+	Orders []OrderDto `json:"orders"`
 }
 
 type ReleaseModel struct {
+	ReleaseId       int
+	MasterId        int
+	ArtistId        int
+	LabelId         int
+	CatNo           string
+	InstanceId      int
+	FolderId        int
 	Title           string
 	Rating          uint8
 	Year            int
@@ -98,32 +105,51 @@ type ReleaseModel struct {
 	MediaCondition  string
 	SleeveCondition string
 	Note            string
+	Fields          []FieldValueModel
 	ThumbUrl        string
 	Format          string
 }
 
-func MapCollectionReleases(releases []DiscogsReleaseDto[[]NoteDto]) ([]ReleaseModel, error) {
+// MapCollectionReleases maps collection releases, resolving their notes with the user's custom fields.
+func MapCollectionReleases(releases []DiscogsReleaseDto[[]NoteDto], fields []CollectionFieldModel) ([]ReleaseModel, error) {
+	fieldsById := make(map[int]CollectionFieldModel, len(fields))
+	for _, field := range fields {
+		fieldsById[field.Id] = field
+	}
+
 	data := make([]ReleaseModel, len(releases))
 	for i, release := range releases {
 		tmp := ReleaseModel{
-			Title:    release.BasicInformation.Title,
-			Rating:   release.Rating,
-			Year:     release.BasicInformation.Year,
-			Artist:   release.BasicInformation.Artists[0].Name,
-			Label:    release.BasicInformation.Labels[0].Name,
-			Genre:    strings.Join(release.BasicInformation.Genres, ", "),
-			Style:    strings.Join(release.BasicInformation.Styles, ", "),
-			ThumbUrl: release.BasicInformation.Thumb,
+			ReleaseId:  release.BasicInformation.Id,
+			MasterId:   release.BasicInformation.MasterId,
+			ArtistId:   release.BasicInformation.Artists[0].Id,
+			LabelId:    release.BasicInformation.Labels[0].Id,
+			CatNo:      release.BasicInformation.Labels[0].CatNo,
+			InstanceId: release.InstanceID,
+			FolderId:   release.FolderId,
+			Title:      release.BasicInformation.Title,
+			Rating:     release.Rating,
+			Year:       release.BasicInformation.Year,
+			Artist:     release.BasicInformation.Artists[0].Name,
+			Label:      release.BasicInformation.Labels[0].Name,
+			Genre:      strings.Join(release.BasicInformation.Genres, ", "),
+			Style:      strings.Join(release.BasicInformation.Styles, ", "),
+			ThumbUrl:   release.BasicInformation.Thumb,
+		}
+		// Map notes to custom fields in field order, unset fields stay empty
+		for _, field := range fields {
+			value := ""
+			for _, note := range release.Notes {
+				if note.FieldId == field.Id {
+					value = note.Value
+				}
+			}
+			tmp.SetField(field, value)
 		}
-		// Map notes to conditions
+		// Keep notes of fields that were deleted since the fields were loaded
 		for _, note := range release.Notes {
-			switch note.FieldId {
-			case 1:
-				tmp.MediaCondition = note.Value
-			case 2:
-				tmp.SleeveCondition = note.Value
-			case 3:
-				tmp.Note = note.Value
+			if _, ok := fieldsById[note.FieldId]; !ok {
+				tmp.Fields = append(tmp.Fields, FieldValueModel{FieldId: note.FieldId, Name: fmt.Sprintf("Field %d", note.FieldId), Value: note.Value})
 			}
 		}
 		// Map formats to a single string
@@ -142,15 +168,20 @@ func MapWishlistReleases(releases []DiscogsReleaseDto[string]) ([]ReleaseModel,
 	data := make([]ReleaseModel, len(releases))
 	for i, release := range releases {
 		tmp := ReleaseModel{
-			Title:    release.BasicInformation.Title,
-			Rating:   release.Rating,
-			Year:     release.BasicInformation.Year,
-			Artist:   release.BasicInformation.Artists[0].Name,
-			Label:    release.BasicInformation.Labels[0].Name,
-			Genre:    strings.Join(release.BasicInformation.Genres, ", "),
-			Style:    strings.Join(release.BasicInformation.Styles, ", "),
-			ThumbUrl: release.BasicInformation.Thumb,
-			Note:     release.Notes,
+			ReleaseId: release.BasicInformation.Id,
+			MasterId:  release.BasicInformation.MasterId,
+			ArtistId:  release.BasicInformation.Artists[0].Id,
+			LabelId:   release.BasicInformation.Labels[0].Id,
+			CatNo:     release.BasicInformation.Labels[0].CatNo,
+			Title:     release.BasicInformation.Title,
+			Rating:    release.Rating,
+			Year:      release.BasicInformation.Year,
+			Artist:    release.BasicInformation.Artists[0].Name,
+			Label:     release.BasicInformation.Labels[0].Name,
+			Genre:     strings.Join(release.BasicInformation.Genres, ", "),
+			Style:     strings.Join(release.BasicInformation.Styles, ", "),
+			ThumbUrl:  release.BasicInformation.Thumb,
+			Note:      release.Notes,
 		}
 		// Map formats to a single string
 		formats := make([]string, len(release.BasicInformation.Formats))
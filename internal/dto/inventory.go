@@ -0,0 +1,116 @@
+package dto
+
+import "time"
+
+// Marketplace listing statuses as used by the Discogs API.
+const (
+	ListingStatusForSale   = "For Sale"
+	ListingStatusDraft     = "Draft"
+	ListingStatusExpired   = "Expired"
+	ListingStatusSold      = "Sold"
+	ListingStatusDeleted   = "Deleted"
+	ListingStatusSuspended = "Suspended"
+	ListingStatusViolation = "Violation"
+)
+
+// ListingStatuses lists every status the inventory can be filtered by.
+var ListingStatuses = []string{
+	ListingStatusForSale,
+	ListingStatusDraft,
+	ListingStatusExpired,
+	ListingStatusSold,
+	ListingStatusDeleted,
+	ListingStatusSuspended,
+	ListingStatusViolation,
+}
+
+type ListingReleaseDto struct {
+	Id            int    `json:"id"`
+	Description   string `json:"description"`
+	Artist        string `json:"artist"`
+	Title         string `json:"title"`
+	Year          int    `json:"year"`
+	Format        string `json:"format"`
+	CatalogNumber string `json:"catalog_number"`
+	Thumbnail     string `json:"thumbnail"`
+}
+
+type ListingDto struct {
+	Id              int               `json:"id"`
+	Status          string            `json:"status"`
+	Price           PriceDto          `json:"price"`
+	AllowOffers     bool              `json:"allow_offers"`
+	Condition       string            `json:"condition"`
+	SleeveCondition string            `json:"sleeve_condition"`
+	ShipsFrom       string            `json:"ships_from"`
+	Comments        string            `json:"comments"`
+	Location        string            `json:"location"`
+	Posted          string            `json:"posted"`
+	Release         ListingReleaseDto `json:"release"`
+}
+
+type InventoryBaseDto struct {
+	PaginationBaseDto
+	Listings []ListingDto `json:"listings"`
+}
+
+type ListingModel struct {
+	Id              int
+	Status          string
+	ReleaseId       int
+	Description     string
+	Artist          string
+	Title           string
+	CatNo           string
+	Condition       string
+	SleeveCondition string
+	Price           PriceDto
+	AllowOffers     bool
+	Comments        string
+	Location        string
+	Posted          time.Time
+}
+
+// InventoryModel is one page of the user's inventory.
+type InventoryModel struct {
+	Listings   []ListingModel
+	Page       int
+	Pages      int
+	TotalItems int
+}
+
+func MapListing(listing ListingDto) ListingModel {
+	tmp := ListingModel{
+		Id:              listing.Id,
+		Status:          listing.Status,
+		ReleaseId:       listing.Release.Id,
+		Description:     listing.Release.Description,
+		Artist:          listing.Release.Artist,
+		Title:           listing.Release.Title,
+		CatNo:           listing.Release.CatalogNumber,
+		Condition:       listing.Condition,
+		SleeveCondition: listing.SleeveCondition,
+		Price:           listing.Price,
+		AllowOffers:     listing.AllowOffers,
+		Comments:        listing.Comments,
+		Location:        listing.Location,
+	}
+	// The timestamp is optional, keep the zero time when missing
+	if posted, err := time.Parse(time.RFC3339, listing.Posted); err == nil {
+		tmp.Posted = posted
+	}
+	return tmp
+}
+
+func MapInventory(page InventoryBaseDto) (InventoryModel, error) {
+	data := InventoryModel{
+		Listings:   make([]ListingModel, len(page.Listings)),
+		Page:       page.Pagination.Page,
+		Pages:      page.Pagination.Pages,
+		TotalItems: page.Pagination.Items,
+	}
+	for i, listing := range page.Listings {
+		data.Listings[i] = MapListing(listing)
+	}
+	return data, nil
+}
@@ -0,0 +1,86 @@
+package dto
+
+import "time"
+
+// Inventory job kinds.
+const (
+	JobKindExport = "export"
+	JobKindAdd    = "add"
+	JobKindChange = "change"
+	JobKindDelete = "delete"
+)
+
+// Inventory job statuses as used by the Discogs API.
+const (
+	JobStatusPending    = "pending"
+	JobStatusInProgress = "in_progress"
+	JobStatusSuccess    = "success"
+	JobStatusFailure    = "failure"
+)
+
+type InventoryJobDto struct {
+	Id          int    `json:"id"`
+	Status      string `json:"status"`
+	Type        string `json:"type"`
+	Filename    string `json:"filename"`
+	Results     string `json:"results"`
+	CreatedTs   string `json:"created_ts"`
+	FinishedTs  string `json:"finished_ts"`
+	DownloadUrl string `json:"download_url"`
+}
+
+type InventoryJobsBaseDto struct {
+	PaginationBaseDto
+	Items []InventoryJobDto `json:"items"`
+}
+
+// InventoryJobModel is an inventory export or CSV upload processed by Discogs in the background.
+type InventoryJobModel struct {
+	Id       int
+	Kind     string
+	Status   string
+	Filename string
+	Results  string
+	Created  time.Time
+	Finished time.Time
+}
+
+// Done reports whether Discogs finished processing the job, successfully or not.
+func (j InventoryJobModel) Done() bool {
+	return !j.Finished.IsZero() || j.Status == JobStatusSuccess || j.Status == JobStatusFailure
+}
+
+// parseJobTime parses job timestamps, which Discogs sends without a time zone
+func parseJobTime(value string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed
+		}
+	}
+	return time.Time{}
+}
+
+// MapInventoryJob maps a job; exports have no type so kind is used when it is missing.
+func MapInventoryJob(job InventoryJobDto, kind string) InventoryJobModel {
+	tmp := InventoryJobModel{
+		Id:       job.Id,
+		Kind:     job.Type,
+		Status:   job.Status,
+		Filename: job.Filename,
+		Results:  job.Results,
+		Created:  parseJobTime(job.CreatedTs),
+		Finished: parseJobTime(job.FinishedTs),
+	}
+	if tmp.Kind == "" {
+		tmp.Kind = kind
+	}
+	return tmp
+}
+
+func MapInventoryJobs(jobs []InventoryJobDto, kind string) ([]InventoryJobModel, error) {
+	data := make([]InventoryJobModel, len(jobs))
+	for i, job := range jobs {
+		data[i] = MapInventoryJob(job, kind)
+	}
+	return data, nil
+}
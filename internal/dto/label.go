@@ -0,0 +1,161 @@
+package dto
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type DiscogsLabelRefDto struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type DiscogsLabelDto struct {
+	Id          int                  `json:"id"`
+	Name        string               `json:"name"`
+	Profile     string               `json:"profile"`
+	ContactInfo string               `json:"contact_info"`
+	Urls        []string             `json:"urls"`
+	ParentLabel *DiscogsLabelRefDto  `json:"parent_label"`
+	Sublabels   []DiscogsLabelRefDto `json:"sublabels"`
+}
+
+type DiscogsLabelReleaseDto struct {
+	Id     int                    `json:"id"`
+	Title  string                 `json:"title"`
+	Artist string                 `json:"artist"`
+	CatNo  string                 `json:"catno"`
+	Format string                 `json:"format"`
+	Year   int                    `json:"year"`
+	Status string                 `json:"status"`
+	Thumb  string                 `json:"thumb"`
+	Stats  DiscogsVersionStatsDto `json:"stats"`
+}
+
+type LabelReleasesBaseDto struct {
+	PaginationBaseDto
+	Releases []DiscogsLabelReleaseDto `json:"releases"`
+}
+
+type LabelModel struct {
+	Id          int
+	Name        string
+	Profile     string
+	ContactInfo string
+	Urls        []string
+	ParentLabel string
+	Sublabels   []string
+}
+
+type LabelReleaseModel struct {
+	ReleaseId    int
+	Title        string
+	Artist       string
+	CatNo        string
+	Format       string
+	Year         int
+	InCollection bool
+	InWantlist   bool
+}
+
+type LabelReleasesModel struct {
+	Releases   []LabelReleaseModel
+	Page       int
+	Pages      int
+	TotalItems int
+}
+
+func MapLabel(label DiscogsLabelDto) (LabelModel, error) {
+	tmp := LabelModel{
+		Id:          label.Id,
+		Name:        label.Name,
+		Profile:     label.Profile,
+		ContactInfo: label.ContactInfo,
+		Urls:        label.Urls,
+		Sublabels:   make([]string, len(label.Sublabels)),
+	}
+	if label.ParentLabel != nil {
+		tmp.ParentLabel = label.ParentLabel.Name
+	}
+	for i, sublabel := range label.Sublabels {
+		tmp.Sublabels[i] = sublabel.Name
+	}
+	return tmp, nil
+}
+
+// MapLabelReleases maps a label catalog sorted by catalog number.
+func MapLabelReleases(releases []DiscogsLabelReleaseDto) ([]LabelReleaseModel, error) {
+	data := make([]LabelReleaseModel, len(releases))
+	for i, release := range releases {
+		data[i] = LabelReleaseModel{
+			ReleaseId:    release.Id,
+			Title:        release.Title,
+			Artist:       release.Artist,
+			CatNo:        release.CatNo,
+			Format:       release.Format,
+			Year:         release.Year,
+			InCollection: release.Stats.User.InCollection > 0,
+			InWantlist:   release.Stats.User.InWantlist > 0,
+		}
+	}
+	SortLabelReleases(data)
+	return data, nil
+}
+
+// MapLabelReleasePage maps one page of a label catalog.
+func MapLabelReleasePage(page LabelReleasesBaseDto) (LabelReleasesModel, error) {
+	releases, err := MapLabelReleases(page.Releases)
+	if err != nil {
+		return LabelReleasesModel{}, err
+	}
+	return LabelReleasesModel{
+		Releases:   releases,
+		Page:       page.Pagination.Page,
+		Pages:      page.Pagination.Pages,
+		TotalItems: page.Pagination.Items,
+	}, nil
+}
+
+// SortLabelReleases sorts releases by catalog number.
+func SortLabelReleases(releases []LabelReleaseModel) {
+	sort.SliceStable(releases, func(i, j int) bool {
+		return CatNoLess(releases[i].CatNo, releases[j].CatNo)
+	})
+}
+
+// CatNoLess compares catalog numbers naturally so that "ABC-9" sorts before
+// "ABC-10". Releases without a catalog number ("none") sort last.
+func CatNoLess(a, b string) bool {
+	a, b = strings.ToLower(strings.TrimSpace(a)), strings.ToLower(strings.TrimSpace(b))
+	if noneA, noneB := a == "" || a == "none", b == "" || b == "none"; noneA || noneB {
+		return !noneA && noneB
+	}
+
+	for a != "" && b != "" {
+		chunkA, restA := catNoChunk(a)
+		chunkB, restB := catNoChunk(b)
+		if chunkA != chunkB {
+			numA, errA := strconv.Atoi(chunkA)
+			numB, errB := strconv.Atoi(chunkB)
+			if errA == nil && errB == nil && numA != numB {
+				return numA < numB
+			}
+			return chunkA < chunkB
+		}
+		a, b = restA, restB
+	}
+	return len(a) < len(b)
+}
+
+// catNoChunk splits off the leading run of digits or non-digits of s.
+func catNoChunk(s string) (chunk string, rest string) {
+	digit := unicode.IsDigit(rune(s[0]))
+	for i, r := range s {
+		if unicode.IsDigit(r) != digit {
+			return s[:i], s[i:]
+		}
+	}
+	return s, ""
+}
@@ -0,0 +1,116 @@
+package dto
+
+import (
+	"strings"
+	"time"
+)
+
+type UserListDto struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Public      bool   `json:"public"`
+	DateChanged string `json:"date_changed"`
+}
+
+type ListsBaseDto struct {
+	PaginationBaseDto
+	Lists []UserListDto `json:"lists"`
+}
+
+type ListItemDto struct {
+	Id           int    `json:"id"`
+	Type         string `json:"type"`
+	DisplayTitle string `json:"display_title"`
+	Comment      string `json:"comment"`
+	ImageUrl     string `json:"image_url"`
+}
+
+type ListDto struct {
+	Id          int           `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Public      bool          `json:"public"`
+	User        OrderUserDto  `json:"user"`
+	Items       []ListItemDto `json:"items"`
+}
+
+type ListSummaryModel struct {
+	Id          int
+	Name        string
+	Description string
+	Public      bool
+	Changed     time.Time
+}
+
+type ListItemModel struct {
+	Id       int
+	Type     string
+	Title    string
+	Comment  string
+	ImageUrl string
+}
+
+// Release returns a release item as the model of a release card. Lists only carry the
+// display title ("Artist - Title"), so the other release details are left empty.
+func (item ListItemModel) Release() ReleaseModel {
+	artist, title, ok := strings.Cut(item.Title, " - ")
+	if !ok {
+		artist, title = "", item.Title
+	}
+	return ReleaseModel{
+		ReleaseId: item.Id,
+		Title:     title,
+		Artist:    artist,
+		Note:      item.Comment,
+		ThumbUrl:  item.ImageUrl,
+	}
+}
+
+type ListModel struct {
+	Id          int
+	Name        string
+	Description string
+	Owner       string
+	Public      bool
+	Items       []ListItemModel
+}
+
+func MapLists(lists []UserListDto) ([]ListSummaryModel, error) {
+	data := make([]ListSummaryModel, len(lists))
+	for i, list := range lists {
+		tmp := ListSummaryModel{
+			Id:          list.Id,
+			Name:        list.Name,
+			Description: list.Description,
+			Public:      list.Public,
+		}
+		// The timestamp is optional, keep the zero time when missing
+		if changed, err := time.Parse(time.RFC3339, list.DateChanged); err == nil {
+			tmp.Changed = changed
+		}
+		data[i] = tmp
+	}
+	return data, nil
+}
+
+func MapList(list ListDto) (ListModel, error) {
+	data := ListModel{
+		Id:          list.Id,
+		Name:        list.Name,
+		Description: list.Description,
+		Owner:       list.User.Username,
+		Public:      list.Public,
+		Items:       make([]ListItemModel, len(list.Items)),
+	}
+	for i, item := range list.Items {
+		data.Items[i] = ListItemModel{
+			Id:       item.Id,
+			Type:     item.Type,
+			Title:    item.DisplayTitle,
+			Comment:  item.Comment,
+			ImageUrl: item.ImageUrl,
+		}
+	}
+	return data, nil
+}
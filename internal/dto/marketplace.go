@@ -0,0 +1,90 @@
+package dto
+
+// Media conditions from best to worst, as used by the Discogs marketplace.
+const (
+	ConditionMint         = "Mint (M)"
+	ConditionNearMint     = "Near Mint (NM or M-)"
+	ConditionVeryGoodPlus = "Very Good Plus (VG+)"
+	ConditionVeryGood     = "Very Good (VG)"
+	ConditionGoodPlus     = "Good Plus (G+)"
+	ConditionGood         = "Good (G)"
+	ConditionFair         = "Fair (F)"
+	ConditionPoor         = "Poor (P)"
+)
+
+// Conditions lists the media conditions from best to worst.
+var Conditions = []string{
+	ConditionMint,
+	ConditionNearMint,
+	ConditionVeryGoodPlus,
+	ConditionVeryGood,
+	ConditionGoodPlus,
+	ConditionGood,
+	ConditionFair,
+	ConditionPoor,
+}
+
+// Sleeve conditions that are not media conditions.
+const (
+	SleeveConditionGeneric   = "Generic"
+	SleeveConditionNotGraded = "Not Graded"
+	SleeveConditionNoCover   = "No Cover"
+)
+
+// SleeveConditions lists the sleeve conditions from best to worst.
+var SleeveConditions = append(append([]string{}, Conditions...),
+	SleeveConditionGeneric,
+	SleeveConditionNotGraded,
+	SleeveConditionNoCover,
+)
+
+type MarketplaceStatsDto struct {
+	LowestPrice     *PriceDto `json:"lowest_price"`
+	NumForSale      int       `json:"num_for_sale"`
+	BlockedFromSale bool      `json:"blocked_from_sale"`
+}
+
+type PriceSuggestionsDto map[string]PriceDto
+
+// ReleaseStatsModel is the marketplace summary of a release. LowestPrice is nil when nothing is for sale.
+type ReleaseStatsModel struct {
+	LowestPrice *PriceDto
+	NumForSale  int
+	Blocked     bool
+}
+
+type ConditionPriceModel struct {
+	Condition string
+	Price     PriceDto
+}
+
+// PriceSuggestionsModel holds the suggested prices from best to worst condition.
+type PriceSuggestionsModel []ConditionPriceModel
+
+// ForCondition returns the suggested price for a condition.
+func (s PriceSuggestionsModel) ForCondition(condition string) (PriceDto, bool) {
+	for _, suggestion := range s {
+		if suggestion.Condition == condition {
+			return suggestion.Price, true
+		}
+	}
+	return PriceDto{}, false
+}
+
+func MapReleaseStats(stats MarketplaceStatsDto) (ReleaseStatsModel, error) {
+	return ReleaseStatsModel{
+		LowestPrice: stats.LowestPrice,
+		NumForSale:  stats.NumForSale,
+		Blocked:     stats.BlockedFromSale,
+	}, nil
+}
+
+func MapPriceSuggestions(suggestions PriceSuggestionsDto) (PriceSuggestionsModel, error) {
+	data := make(PriceSuggestionsModel, 0, len(suggestions))
+	for _, condition := range Conditions {
+		if price, ok := suggestions[condition]; ok {
+			data = append(data, ConditionPriceModel{Condition: condition, Price: price})
+		}
+	}
+	return data, nil
+}
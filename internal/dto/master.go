@@ -0,0 +1,105 @@
+package dto
+
+type DiscogsVersionUserStatsDto struct {
+	InCollection int `json:"in_collection"`
+	InWantlist   int `json:"in_wantlist"`
+}
+
+type DiscogsVersionStatsDto struct {
+	User      DiscogsVersionUserStatsDto `json:"user"`
+	Community DiscogsVersionUserStatsDto `json:"community"`
+}
+
+type DiscogsMasterVersionDto struct {
+	Id           int                    `json:"id"`
+	Status       string                 `json:"status"`
+	Title        string                 `json:"title"`
+	Format       string                 `json:"format"`
+	MajorFormats []string               `json:"major_formats"`
+	Label        string                 `json:"label"`
+	CatNo        string                 `json:"catno"`
+	Country      string                 `json:"country"`
+	Released     string                 `json:"released"`
+	Thumb        string                 `json:"thumb"`
+	Stats        DiscogsVersionStatsDto `json:"stats"`
+}
+
+type MasterVersionsBaseDto struct {
+	PaginationBaseDto
+	Versions []DiscogsMasterVersionDto `json:"versions"`
+}
+
+type DiscogsMasterDto struct {
+	Id          int                       `json:"id"`
+	Title       string                    `json:"title"`
+	Year        int                       `json:"year"`
+	MainRelease int                       `json:"main_release"`
+	Artists     []DiscogsReleaseArtistDto `json:"artists"`
+}
+
+type MasterModel struct {
+	Id          int
+	Title       string
+	Artist      string
+	Year        int
+	MainRelease int
+}
+
+type MasterVersionModel struct {
+	ReleaseId    int
+	Title        string
+	Format       string
+	Label        string
+	CatNo        string
+	Country      string
+	Released     string
+	ThumbUrl     string
+	InCollection bool
+	InWantlist   bool
+	Have         int
+	Want         int
+}
+
+// MasterVersionsModel is one page of the versions of a master release.
+type MasterVersionsModel struct {
+	Versions   []MasterVersionModel
+	Page       int
+	Pages      int
+	TotalItems int
+}
+
+func MapMaster(master DiscogsMasterDto) (MasterModel, error) {
+	return MasterModel{
+		Id:          master.Id,
+		Title:       master.Title,
+		Artist:      joinArtists(master.Artists),
+		Year:        master.Year,
+		MainRelease: master.MainRelease,
+	}, nil
+}
+
+func MapMasterVersions(page MasterVersionsBaseDto) (MasterVersionsModel, error) {
+	data := MasterVersionsModel{
+		Versions:   make([]MasterVersionModel, len(page.Versions)),
+		Page:       page.Pagination.Page,
+		Pages:      page.Pagination.Pages,
+		TotalItems: page.Pagination.Items,
+	}
+	for i, version := range page.Versions {
+		data.Versions[i] = MasterVersionModel{
+			ReleaseId:    version.Id,
+			Title:        version.Title,
+			Format:       version.Format,
+			Label:        version.Label,
+			CatNo:        version.CatNo,
+			Country:      version.Country,
+			Released:     version.Released,
+			ThumbUrl:     version.Thumb,
+			InCollection: version.Stats.User.InCollection > 0,
+			InWantlist:   version.Stats.User.InWantlist > 0,
+			Have:         version.Stats.Community.InCollection,
+			Want:         version.Stats.Community.InWantlist,
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,221 @@
+package dto
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Marketplace order statuses as used by the Discogs API.
+const (
+	OrderStatusNewOrder          = "New Order"
+	OrderStatusBuyerContacted    = "Buyer Contacted"
+	OrderStatusInvoiceSent       = "Invoice Sent"
+	OrderStatusPaymentPending    = "Payment Pending"
+	OrderStatusPaymentReceived   = "Payment Received"
+	OrderStatusInProgress        = "In Progress"
+	OrderStatusShipped           = "Shipped"
+	OrderStatusRefundSent        = "Refund Sent"
+	OrderStatusMerged            = "Merged"
+	OrderStatusCancelledNonPay   = "Cancelled (Non-Paying Buyer)"
+	OrderStatusCancelledNoItem   = "Cancelled (Item Unavailable)"
+	OrderStatusCancelledPerBuyer = "Cancelled (Per Buyer's Request)"
+)
+
+// OrderStatuses lists every order status in workflow order.
+var OrderStatuses = []string{
+	OrderStatusNewOrder,
+	OrderStatusBuyerContacted,
+	OrderStatusInvoiceSent,
+	OrderStatusPaymentPending,
+	OrderStatusPaymentReceived,
+	OrderStatusInProgress,
+	OrderStatusShipped,
+	OrderStatusRefundSent,
+	OrderStatusMerged,
+	OrderStatusCancelledNonPay,
+	OrderStatusCancelledNoItem,
+	OrderStatusCancelledPerBuyer,
+}
+
+type PriceDto struct {
+	Currency string  `json:"currency"`
+	Value    float64 `json:"value"`
+}
+
+func (p PriceDto) String() string {
+	if p.Currency == "" {
+		return fmt.Sprintf("%.2f", p.Value)
+	}
+	return fmt.Sprintf("%.2f %s", p.Value, p.Currency)
+}
+
+type OrderShippingDto struct {
+	Currency string  `json:"currency"`
+	Method   string  `json:"method"`
+	Value    float64 `json:"value"`
+}
+
+type OrderUserDto struct {
+	Id          int    `json:"id"`
+	Username    string `json:"username"`
+	ResourceUrl string `json:"resource_url"`
+}
+
+type OrderReleaseDto struct {
+	Id          int    `json:"id"`
+	Description string `json:"description"`
+}
+
+type OrderItemDto struct {
+	Id              int             `json:"id"`
+	Release         OrderReleaseDto `json:"release"`
+	Price           PriceDto        `json:"price"`
+	MediaCondition  string          `json:"media_condition"`
+	SleeveCondition string          `json:"sleeve_condition"`
+}
+
+type OrderDto struct {
+	Id                     string           `json:"id"`
+	ResourceUrl            string           `json:"resource_url"`
+	MessagesUrl            string           `json:"messages_url"`
+	Uri                    string           `json:"uri"`
+	Status                 string           `json:"status"`
+	NextStatus             []string         `json:"next_status"`
+	Fee                    PriceDto         `json:"fee"`
+	Created                string           `json:"created"`
+	LastActivity           string           `json:"last_activity"`
+	Items                  []OrderItemDto   `json:"items"`
+	Shipping               OrderShippingDto `json:"shipping"`
+	ShippingAddress        string           `json:"shipping_address"`
+	AdditionalInstructions string           `json:"additional_instructions"`
+	Archived               bool             `json:"archived"`
+	Seller                 OrderUserDto     `json:"seller"`
+	Buyer                  OrderUserDto     `json:"buyer"`
+	Total                  PriceDto         `json:"total"`
+}
+
+type OrderItemModel struct {
+	ReleaseId       int
+	Description     string
+	Price           PriceDto
+	MediaCondition  string
+	SleeveCondition string
+}
+
+type OrderModel struct {
+	Id              string
+	Status          string
+	NextStatus      []string
+	Buyer           string
+	Seller          string
+	Items           []OrderItemModel
+	Shipping        PriceDto
+	ShippingMethod  string
+	ShippingAddress string
+	Instructions    string
+	Fee             PriceDto
+	Total           PriceDto
+	Created         time.Time
+	LastActivity    time.Time
+}
+
+// Counterpart returns the other party of the order from username's point of view.
+func (o OrderModel) Counterpart(username string) (role string, name string) {
+	if o.Seller == username {
+		return "Buyer", o.Buyer
+	}
+	return "Seller", o.Seller
+}
+
+func MapOrders(orders []OrderDto) ([]OrderModel, error) {
+	data := make([]OrderModel, len(orders))
+	for i, order := range orders {
+		tmp := OrderModel{
+			Id:              order.Id,
+			Status:          order.Status,
+			NextStatus:      order.NextStatus,
+			Buyer:           order.Buyer.Username,
+			Seller:          order.Seller.Username,
+			Shipping:        PriceDto{Currency: order.Shipping.Currency, Value: order.Shipping.Value},
+			ShippingMethod:  order.Shipping.Method,
+			ShippingAddress: order.ShippingAddress,
+			Instructions:    order.AdditionalInstructions,
+			Fee:             order.Fee,
+			Total:           order.Total,
+		}
+		// Timestamps are optional, keep the zero time when missing
+		if created, err := time.Parse(time.RFC3339, order.Created); err == nil {
+			tmp.Created = created
+		}
+		if lastActivity, err := time.Parse(time.RFC3339, order.LastActivity); err == nil {
+			tmp.LastActivity = lastActivity
+		}
+
+		tmp.Items = make([]OrderItemModel, len(order.Items))
+		for j, item := range order.Items {
+			tmp.Items[j] = OrderItemModel{
+				ReleaseId:       item.Release.Id,
+				Description:     item.Release.Description,
+				Price:           item.Price,
+				MediaCondition:  item.MediaCondition,
+				SleeveCondition: item.SleeveCondition,
+			}
+		}
+
+		data[i] = tmp
+	}
+	return data, nil
+}
+
+type OrderMessageDto struct {
+	Timestamp string       `json:"timestamp"`
+	Type      string       `json:"type"`
+	Subject   string       `json:"subject"`
+	Message   string       `json:"message"`
+	From      OrderUserDto `json:"from"`
+	Actor     OrderUserDto `json:"actor"`
+}
+
+type OrderMessagesBaseDto struct {
+	PaginationBaseDto
+	Messages []OrderMessageDto `json:"messages"`
+}
+
+type OrderMessageModel struct {
+	Timestamp time.Time
+	Type      string
+	From      string
+	Subject   string
+	Message   string
+}
+
+// IsStatusChange reports whether the message was generated by a status change.
+func (m OrderMessageModel) IsStatusChange() bool {
+	return m.Type == "status"
+}
+
+func MapOrderMessages(messages []OrderMessageDto) ([]OrderMessageModel, error) {
+	data := make([]OrderMessageModel, len(messages))
+	for i, message := range messages {
+		tmp := OrderMessageModel{
+			Type:    message.Type,
+			From:    message.From.Username,
+			Subject: message.Subject,
+			Message: message.Message,
+		}
+		// System messages have no sender but may have an actor
+		if tmp.From == "" {
+			tmp.From = message.Actor.Username
+		}
+		if timestamp, err := time.Parse(time.RFC3339, message.Timestamp); err == nil {
+			tmp.Timestamp = timestamp
+		}
+		data[i] = tmp
+	}
+	// Oldest first so the thread reads top to bottom
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Timestamp.Before(data[j].Timestamp)
+	})
+	return data, nil
+}
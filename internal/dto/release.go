@@ -0,0 +1,193 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+)
+
+type DiscogsTrackDto struct {
+	Position     string                    `json:"position"`
+	Type         string                    `json:"type_"`
+	Title        string                    `json:"title"`
+	Duration     string                    `json:"duration"`
+	Artists      []DiscogsReleaseArtistDto `json:"artists"`
+	ExtraArtists []DiscogsReleaseArtistDto `json:"extraartists"`
+}
+
+type DiscogsIdentifierDto struct {
+	Type        string `json:"type"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+}
+
+type DiscogsRatingDto struct {
+	Count   int     `json:"count"`
+	Average float64 `json:"average"`
+}
+
+type DiscogsCommunityDto struct {
+	Have   int              `json:"have"`
+	Want   int              `json:"want"`
+	Rating DiscogsRatingDto `json:"rating"`
+}
+
+type DiscogsReleaseDetailDto struct {
+	Id           int                       `json:"id"`
+	MasterId     int                       `json:"master_id"`
+	Title        string                    `json:"title"`
+	Year         int                       `json:"year"`
+	Released     string                    `json:"released"`
+	Country      string                    `json:"country"`
+	Notes        string                    `json:"notes"`
+	Thumb        string                    `json:"thumb"`
+	Uri          string                    `json:"uri"`
+	Artists      []DiscogsReleaseArtistDto `json:"artists"`
+	ExtraArtists []DiscogsReleaseArtistDto `json:"extraartists"`
+	Labels       []DiscogsReleaseLabelDto  `json:"labels"`
+	Companies    []DiscogsReleaseLabelDto  `json:"companies"`
+	Formats      []DiscogsReleaseFormatDto `json:"formats"`
+	Genres       []string                  `json:"genres"`
+	Styles       []string                  `json:"styles"`
+	Tracklist    []DiscogsTrackDto         `json:"tracklist"`
+	Identifiers  []DiscogsIdentifierDto    `json:"identifiers"`
+	Community    DiscogsCommunityDto       `json:"community"`
+}
+
+type TrackModel struct {
+	Position string
+	Title    string
+	Duration string
+	Artists  string
+	Credits  []CreditModel
+	Heading  bool
+}
+
+type CreditModel struct {
+	Name   string
+	Role   string
+	Tracks string
+}
+
+type IdentifierModel struct {
+	Type        string
+	Value       string
+	Description string
+}
+
+type CompanyModel struct {
+	Name  string
+	Role  string
+	CatNo string
+}
+
+type ReleaseDetailModel struct {
+	Id            int
+	MasterId      int
+	Title         string
+	Artist        string
+	Year          int
+	Released      string
+	Country       string
+	Labels        []string
+	Format        string
+	Genre         string
+	Style         string
+	Notes         string
+	Uri           string
+	Tracklist     []TrackModel
+	Credits       []CreditModel
+	Identifiers   []IdentifierModel
+	Companies     []CompanyModel
+	Have          int
+	Want          int
+	RatingAverage float64
+	RatingCount   int
+}
+
+// joinArtists renders artist credits the way Discogs does, e.g. "A & B feat. C".
+func joinArtists(artists []DiscogsReleaseArtistDto) string {
+	var b strings.Builder
+	for i, artist := range artists {
+		name := artist.Name
+		if artist.Anv != "" {
+			name = artist.Anv
+		}
+		b.WriteString(name)
+		if i < len(artists)-1 {
+			join := strings.TrimSpace(artist.Join)
+			if join == "" || join == "," {
+				b.WriteString(join + " ")
+			} else {
+				b.WriteString(" " + join + " ")
+			}
+		}
+	}
+	return b.String()
+}
+
+func mapCredits(artists []DiscogsReleaseArtistDto) []CreditModel {
+	credits := make([]CreditModel, len(artists))
+	for i, artist := range artists {
+		credits[i] = CreditModel{Name: artist.Name, Role: artist.Role, Tracks: artist.Tracks}
+	}
+	return credits
+}
+
+// formatString renders formats the same way the release cards do.
+func formatString(formats []DiscogsReleaseFormatDto) string {
+	tmp := make([]string, len(formats))
+	for i, format := range formats {
+		tmp[i] = fmt.Sprintf("%sx %s: %s", format.Qty, format.Name, strings.Join(format.Descriptions, "-"))
+	}
+	return strings.Join(tmp, "\n\t")
+}
+
+func MapReleaseDetail(release DiscogsReleaseDetailDto) (ReleaseDetailModel, error) {
+	tmp := ReleaseDetailModel{
+		Id:            release.Id,
+		MasterId:      release.MasterId,
+		Title:         release.Title,
+		Artist:        joinArtists(release.Artists),
+		Year:          release.Year,
+		Released:      release.Released,
+		Country:       release.Country,
+		Format:        formatString(release.Formats),
+		Genre:         strings.Join(release.Genres, ", "),
+		Style:         strings.Join(release.Styles, ", "),
+		Notes:         release.Notes,
+		Uri:           release.Uri,
+		Credits:       mapCredits(release.ExtraArtists),
+		Have:          release.Community.Have,
+		Want:          release.Community.Want,
+		RatingAverage: release.Community.Rating.Average,
+		RatingCount:   release.Community.Rating.Count,
+	}
+
+	tmp.Labels = make([]string, len(release.Labels))
+	for i, label := range release.Labels {
+		tmp.Labels[i] = fmt.Sprintf("%s – %s", label.Name, label.CatNo)
+	}
+
+	tmp.Companies = make([]CompanyModel, len(release.Companies))
+	for i, company := range release.Companies {
+		tmp.Companies[i] = CompanyModel{Name: company.Name, Role: company.EntityTypeName, CatNo: company.CatNo}
+	}
+
+	tmp.Identifiers = make([]IdentifierModel, len(release.Identifiers))
+	for i, identifier := range release.Identifiers {
+		tmp.Identifiers[i] = IdentifierModel{Type: identifier.Type, Value: identifier.Value, Description: identifier.Description}
+	}
+
+	tmp.Tracklist = make([]TrackModel, len(release.Tracklist))
+	for i, track := range release.Tracklist {
+		tmp.Tracklist[i] = TrackModel{
+			Position: track.Position,
+			Title:    track.Title,
+			Duration: track.Duration,
+			Artists:  joinArtists(track.Artists),
+			Credits:  mapCredits(track.ExtraArtists),
+			Heading:  track.Type == "heading",
+		}
+	}
+	return tmp, nil
+}
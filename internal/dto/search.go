@@ -0,0 +1,84 @@
+package dto
+
+import "strings"
+
+type DiscogsSearchUserDataDto struct {
+	InCollection bool `json:"in_collection"`
+	InWantlist   bool `json:"in_wantlist"`
+}
+
+type DiscogsSearchResultDto struct {
+	Id         int                      `json:"id"`
+	Type       string                   `json:"type"`
+	Title      string                   `json:"title"`
+	Thumb      string                   `json:"thumb"`
+	CoverImage string                   `json:"cover_image"`
+	Country    string                   `json:"country"`
+	Year       string                   `json:"year"`
+	Format     []string                 `json:"format"`
+	Label      []string                 `json:"label"`
+	CatNo      string                   `json:"catno"`
+	Barcode    []string                 `json:"barcode"`
+	Genre      []string                 `json:"genre"`
+	Style      []string                 `json:"style"`
+	MasterId   int                      `json:"master_id"`
+	UserData   DiscogsSearchUserDataDto `json:"user_data"`
+}
+
+type SearchBaseDto struct {
+	PaginationBaseDto
+	Results []DiscogsSearchResultDto `json:"results"`
+}
+
+type SearchResultModel struct {
+	Id           int
+	Type         string
+	Title        string
+	ThumbUrl     string
+	Country      string
+	Year         string
+	Format       string
+	Label        string
+	CatNo        string
+	Genre        string
+	Style        string
+	MasterId     int
+	InCollection bool
+	InWantlist   bool
+}
+
+// SearchResultsModel is one page of database search results.
+type SearchResultsModel struct {
+	Results    []SearchResultModel
+	Page       int
+	Pages      int
+	TotalItems int
+}
+
+func MapSearchResults(page SearchBaseDto) (SearchResultsModel, error) {
+	data := SearchResultsModel{
+		Results:    make([]SearchResultModel, len(page.Results)),
+		Page:       page.Pagination.Page,
+		Pages:      page.Pagination.Pages,
+		TotalItems: page.Pagination.Items,
+	}
+	for i, result := range page.Results {
+		data.Results[i] = SearchResultModel{
+			Id:           result.Id,
+			Type:         result.Type,
+			Title:        result.Title,
+			ThumbUrl:     result.Thumb,
+			Country:      result.Country,
+			Year:         result.Year,
+			Format:       strings.Join(result.Format, ", "),
+			Label:        strings.Join(result.Label, ", "),
+			CatNo:        result.CatNo,
+			Genre:        strings.Join(result.Genre, ", "),
+			Style:        strings.Join(result.Style, ", "),
+			MasterId:     result.MasterId,
+			InCollection: result.UserData.InCollection,
+			InWantlist:   result.UserData.InWantlist,
+		}
+	}
+	return data, nil
+}
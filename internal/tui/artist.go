@@ -0,0 +1,207 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// artistPage is the name of the artist page.
+const artistPage = "artist"
+
+// artistBrowser holds the state of the artist page
+type artistBrowser struct {
+	artist   dto.ArtistModel
+	page     int
+	releases dto.ArtistReleasesModel
+
+	profile *tview.TextView
+	table   *tview.Table
+}
+
+// openArtistPage opens the profile and discography of an artist
+func (t *TUI) openArtistPage(artistId int) {
+	if artistId == 0 {
+		t.showWarning("No artist linked to this release")
+		return
+	}
+
+	b := &artistBrowser{
+		artist:  dto.ArtistModel{Id: artistId},
+		page:    1,
+		profile: tview.NewTextView().SetScrollable(true).SetWrap(true).SetWordWrap(true),
+		table:   tview.NewTable().SetSelectable(true, false).SetFixed(1, 0),
+	}
+	b.profile.SetBorder(true).SetTitle("Artist").SetTitleAlign(tview.AlignLeft)
+	b.profile.SetText("Loading artist...")
+	b.table.SetBorder(true).SetTitleAlign(tview.AlignLeft)
+	b.table.SetSelectedFunc(func(row, _ int) {
+		if row <= 0 || row > len(b.releases.Releases) {
+			return
+		}
+		release := b.releases.Releases[row-1]
+		if release.IsMaster {
+			t.openMasterBrowser(release.Id)
+		} else {
+			t.openReleaseDetail(release.Id)
+		}
+	})
+
+	page := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(b.profile, 0, 1, false).
+		AddItem(b.table, 0, 2, true)
+	page.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		switch key.Key() {
+		case tcell.KeyEscape:
+			t.closePage(artistPage)
+			return nil
+		case tcell.KeyPgUp, tcell.KeyPgDn:
+			b.profile.InputHandler()(key, func(tview.Primitive) {})
+			return nil
+		}
+		switch key.Rune() {
+		case 'n':
+			if b.releases.Page < b.releases.Pages {
+				b.page++
+				go t.loadArtistReleases(b, artistId, b.page)
+			}
+			return nil
+		case 'p':
+			if b.page > 1 {
+				b.page--
+				go t.loadArtistReleases(b, artistId, b.page)
+			}
+			return nil
+		}
+		return key
+	})
+
+	t.openPage(artistPage, page)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		artist, err := t.Client.GetArtist(ctx, artistId)
+		current := 1
+		t.awaitUpdateDraw(func() {
+			if err == nil {
+				b.artist = artist
+				b.profile.SetTitle(fmt.Sprintf("%s [ PgUp/PgDn ]", artist.Name))
+				b.profile.SetText(artistProfileText(artist)).ScrollToBeginning()
+			}
+			current = b.page
+		})
+		if err != nil {
+			t.showError(err)
+		}
+		t.loadArtistReleases(b, artistId, current)
+	}()
+}
+
+// loadArtistReleases fetches a discography page and redraws the table. Pages
+// that are no longer asked for by the time they arrive are dropped.
+func (t *TUI) loadArtistReleases(b *artistBrowser, artistId, page int) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	t.showMessage(fmt.Sprintf("Loading discography page %d...", page))
+	releases, err := t.Client.GetArtistReleases(ctx, artistId, page)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.queueUpdateDraw(func() {
+		if page != b.page {
+			return
+		}
+		b.releases = releases
+		t.renderArtistReleases(b)
+	})
+}
+
+// renderArtistReleases fills the discography table marking entries as owned, wanted or missing
+func (t *TUI) renderArtistReleases(b *artistBrowser) {
+	own := t.ownership()
+	owned, wanted := 0, 0
+
+	b.table.Clear()
+	for col, title := range []string{"Year", "Title", "Artist", "Role", "Format", "Label", "Status"} {
+		b.table.SetCell(0, col, tview.NewTableCell(title).SetSelectable(false).SetTextColor(tcell.ColorYellow))
+	}
+	for i, release := range b.releases.Releases {
+		color, status := tcell.ColorGray, "Missing"
+		switch {
+		case release.InCollection || own.owned[release.Id] || (release.IsMaster && own.ownedMasters[release.Id]):
+			color, status = tcell.ColorGreen, "Owned"
+			owned++
+		case release.InWantlist || own.wanted[release.Id] || (release.IsMaster && own.wantedMasters[release.Id]):
+			color, status = tcell.ColorOrange, "Wanted"
+			wanted++
+		}
+
+		title := release.Title
+		if release.IsMaster {
+			title += " [master]"
+		}
+		row := i + 1
+		for col, text := range []string{
+			fmt.Sprint(release.Year),
+			title,
+			release.Artist,
+			release.Role,
+			release.Format,
+			release.Label,
+			status,
+		} {
+			b.table.SetCell(row, col, tview.NewTableCell(tview.Escape(text)).SetTextColor(color).SetMaxWidth(40))
+		}
+	}
+
+	b.table.SetTitle(fmt.Sprintf(
+		"Discography · page %d/%d · %d total · this page: %d owned, %d wanted · Page [ n / p ] · Open [ Enter ] · Close [ Esc ]",
+		b.releases.Page, b.releases.Pages, b.releases.TotalItems, owned, wanted,
+	))
+	b.table.Select(1, 0).ScrollToBeginning()
+}
+
+// artistProfileText renders the profile, members, groups and aliases of an artist
+func artistProfileText(artist dto.ArtistModel) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", artist.Name)
+	if artist.RealName != "" {
+		fmt.Fprintf(&b, "Real name: %s\n", artist.RealName)
+	}
+	if artist.NameVariations != "" {
+		fmt.Fprintf(&b, "Variations: %s\n", artist.NameVariations)
+	}
+
+	refs := func(title string, refs []dto.ArtistRefModel) {
+		if len(refs) == 0 {
+			return
+		}
+		names := make([]string, len(refs))
+		for i, ref := range refs {
+			names[i] = ref.Name
+			if !ref.Active {
+				names[i] += " (inactive)"
+			}
+		}
+		fmt.Fprintf(&b, "%s: %s\n", title, strings.Join(names, ", "))
+	}
+	refs("Members", artist.Members)
+	refs("Groups", artist.Groups)
+	refs("Aliases", artist.Aliases)
+
+	if artist.Profile != "" {
+		fmt.Fprintf(&b, "\n%s\n", artist.Profile)
+	}
+	for _, url := range artist.Urls {
+		fmt.Fprintf(&b, "%s\n", url)
+	}
+	return b.String()
+}
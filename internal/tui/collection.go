@@ -0,0 +1,518 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// pickFolder fetches the collection folders and lets the user choose one a release can be added to
+func (t *TUI) pickFolder(title string, onPick func(dto.FolderModel)) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		folders, err := t.Client.GetFolders(ctx)
+		if err != nil {
+			t.showError(err)
+			return
+		}
+
+		t.queueUpdateDraw(func() {
+			list := tview.NewList().ShowSecondaryText(false)
+			list.SetBorder(true).SetTitle(title)
+			for _, folder := range folders {
+				// The virtual "All" folder can't hold releases
+				if folder.Id == client.AllFolderId {
+					continue
+				}
+				folder := folder
+				list.AddItem(fmt.Sprintf("%s (%d)", folder.Name, folder.Count), "", 0, func() {
+					t.closePage("dialog")
+					onPick(folder)
+				})
+			}
+			list.SetDoneFunc(func() { t.closePage("dialog") })
+			t.openDialog(list, 50, min(list.GetItemCount()+2, 20))
+		})
+	}()
+}
+
+// setFolders stores the collection folders, rebuilds the folder tree and applies the folder filter
+func (t *TUI) setFolders(folders []dto.FolderModel) {
+	t.awaitUpdateDraw(func() {
+		t.Folders = t.Folders[:0]
+		for _, folder := range folders {
+			// Folder 0 is the Collection entry itself
+			if folder.Id != client.AllFolderId {
+				t.Folders = append(t.Folders, folder)
+			}
+		}
+		if _, ok := t.folderById(t.SelectedFolder); !ok {
+			t.SelectedFolder = client.AllFolderId
+		}
+
+		// The folder tree sits right below the Collection entry. Rebuilding it
+		// shifts the items, so selection changes are ignored until it's done.
+		current := t.Navigation.GetCurrentItem()
+		t.Navigation.SetChangedFunc(nil)
+		for range t.folderItems {
+			t.Navigation.RemoveItem(1)
+		}
+		for i, folder := range t.Folders {
+			branch := "├─"
+			if i == len(t.Folders)-1 {
+				branch = "└─"
+			}
+			t.Navigation.InsertItem(1+i,
+				fmt.Sprintf(" %s %s", branch, folder.Name),
+				fmt.Sprintf("    %d releases", folder.Count),
+				0, t.focusOnPreview(client.CollectionSource))
+		}
+		switch {
+		case current == 0:
+		case current <= t.folderItems:
+			current = 1 + t.folderIndex(t.SelectedFolder)
+		default:
+			current += len(t.Folders) - t.folderItems
+		}
+		t.folderItems = len(t.Folders)
+		t.Navigation.SetCurrentItem(current)
+		t.Navigation.SetChangedFunc(t.sourceSelected)
+
+		t.applyFolderFilter()
+	})
+}
+
+// applyFolderFilter fills CollectionPrims with the cards of the releases in SelectedFolder
+func (t *TUI) applyFolderFilter() {
+	cards := make([]*tview.Flex, 0, len(t.collectionCards))
+	for i, model := range t.Collection {
+		if t.SelectedFolder == client.AllFolderId || model.FolderId == t.SelectedFolder {
+			cards = append(cards, t.collectionCards[i])
+		}
+	}
+	t.CollectionPrims = cards
+}
+
+// folderAt returns the folder shown at index in the navigation list
+func (t *TUI) folderAt(index int) (dto.FolderModel, bool) {
+	if index < 1 || index > len(t.Folders) {
+		return dto.FolderModel{}, false
+	}
+	return t.Folders[index-1], true
+}
+
+// folderIndex returns the position of a folder in Folders or -1
+func (t *TUI) folderIndex(folderId int) int {
+	for i, folder := range t.Folders {
+		if folder.Id == folderId {
+			return i
+		}
+	}
+	return -1
+}
+
+// folderById looks up a folder by ID; the All folder always exists
+func (t *TUI) folderById(folderId int) (dto.FolderModel, bool) {
+	if folderId == client.AllFolderId {
+		return dto.FolderModel{Id: client.AllFolderId, Name: "All", Count: len(t.Collection)}, true
+	}
+	if index := t.folderIndex(folderId); index >= 0 {
+		return t.Folders[index], true
+	}
+	return dto.FolderModel{}, false
+}
+
+// folderName returns the name of a folder for titles
+func (t *TUI) folderName(folderId int) string {
+	if folder, ok := t.folderById(folderId); ok {
+		return folder.Name
+	}
+	return fmt.Sprintf("Folder %d", folderId)
+}
+
+// navigationInput handles the folder key bindings on the Collection entry and the folder tree
+func (t *TUI) navigationInput(key *tcell.EventKey) *tcell.EventKey {
+	index := t.Navigation.GetCurrentItem()
+	folder, isFolder := t.folderAt(index)
+	if index != 0 && !isFolder {
+		return key
+	}
+
+	switch key.Rune() {
+	case 'n':
+		t.openFolderNameForm("New folder", "", func(ctx context.Context, name string) error {
+			folder, err := t.Client.CreateFolder(ctx, name)
+			if err == nil {
+				t.showMessage(fmt.Sprintf("✓ Created folder %s", folder.Name))
+			}
+			return err
+		})
+		return nil
+	case 'r':
+		if !isFolder {
+			return nil
+		}
+		if folder.Id == client.UncategorizedFolderId {
+			t.showWarning("The Uncategorized folder can't be renamed")
+			return nil
+		}
+		t.openFolderNameForm("Rename folder", folder.Name, func(ctx context.Context, name string) error {
+			_, err := t.Client.RenameFolder(ctx, folder.Id, name)
+			if err == nil {
+				t.showMessage(fmt.Sprintf("✓ Renamed %s to %s", folder.Name, name))
+			}
+			return err
+		})
+		return nil
+	case 'd':
+		if !isFolder {
+			return nil
+		}
+		if folder.Id == client.UncategorizedFolderId {
+			t.showWarning("The Uncategorized folder can't be deleted")
+			return nil
+		}
+		if folder.Count > 0 {
+			t.showWarning(fmt.Sprintf("Folder %s is not empty, move its releases first", folder.Name))
+			return nil
+		}
+		t.confirm(fmt.Sprintf("Delete folder %s?", folder.Name), func() {
+			t.App.SetFocus(t.Navigation)
+			go t.updateFolders(func(ctx context.Context) error {
+				err := t.Client.DeleteFolder(ctx, folder.Id)
+				if err == nil {
+					t.showMessage(fmt.Sprintf("✓ Deleted folder %s", folder.Name))
+				}
+				return err
+			})
+		})
+		return nil
+	}
+	return key
+}
+
+// openFolderNameForm asks for a folder name and passes it to save
+func (t *TUI) openFolderNameForm(title, name string, save func(ctx context.Context, name string) error) {
+	form := tview.NewForm().AddInputField("Name", name, 30, nil, nil)
+	closeForm := func() {
+		t.closePage("dialog")
+		t.App.SetFocus(t.Navigation)
+	}
+	form.AddButton("Save", func() {
+		name := strings.TrimSpace(form.GetFormItemByLabel("Name").(*tview.InputField).GetText())
+		if name == "" {
+			t.showWarning("Folder name can't be empty")
+			return
+		}
+		closeForm()
+		go t.updateFolders(func(ctx context.Context) error { return save(ctx, name) })
+	}).AddButton("Cancel", closeForm)
+	form.SetBorder(true).SetTitle(title)
+	form.SetCancelFunc(closeForm)
+
+	t.openDialog(form, 46, 7)
+}
+
+// updateFolders runs a folder change and reloads the folder tree afterwards
+func (t *TUI) updateFolders(change func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := change(ctx); err != nil {
+		t.showError(err)
+		return
+	}
+	folders, err := t.Client.GetFolders(ctx)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.setFolders(folders)
+	t.DrawPreviewGrid()
+}
+
+// collectionCardInput handles the collection key bindings of a focused release card.
+// The model is looked up by instance when a key is pressed so the card never acts on stale data.
+func (t *TUI) collectionCardInput(model dto.ReleaseModel) func(*tcell.EventKey) *tcell.EventKey {
+	releaseInput := t.releaseCardInput(model)
+	return func(key *tcell.EventKey) *tcell.EventKey {
+		index := t.collectionIndex(model.InstanceId)
+		if index < 0 {
+			return releaseInput(key)
+		}
+		current := t.Collection[index]
+
+		switch r := key.Rune(); r {
+		case '0', '1', '2', '3', '4', '5':
+			t.rateCollectionInstance(current, uint8(r-'0'))
+			return nil
+		case 'c':
+			t.pickFolder("Add another copy to folder", func(folder dto.FolderModel) {
+				t.confirm(fmt.Sprintf("Add another copy of %s to %s?", current.Title, folder.Name), func() {
+					go t.addCollectionInstance(current, folder)
+				})
+			})
+			return nil
+		case 'v':
+			t.pickFolder("Move to folder", func(folder dto.FolderModel) {
+				if folder.Id == current.FolderId {
+					t.showWarning(fmt.Sprintf("%s is already in %s", current.Title, folder.Name))
+					return
+				}
+				t.confirm(fmt.Sprintf("Move %s from %s to %s?", current.Title, t.folderName(current.FolderId), folder.Name), func() {
+					go t.moveCollectionInstance(current, folder)
+				})
+			})
+			return nil
+		case 'e':
+			go t.openFieldsForm(current)
+			return nil
+		case 's':
+			t.openSellForm(current)
+			return nil
+		case 'x':
+			t.confirm(fmt.Sprintf("Remove this copy of %s from %s?", current.Title, t.folderName(current.FolderId)), func() {
+				go t.deleteCollectionInstance(current)
+			})
+			return nil
+		}
+		return releaseInput(key)
+	}
+}
+
+// rateCollectionInstance shows the new rating on the card right away and rolls it back if the API rejects it
+func (t *TUI) rateCollectionInstance(model dto.ReleaseModel, rating uint8) {
+	if model.Rating == rating {
+		return
+	}
+	t.setCollectionRating(model.InstanceId, rating)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := t.Client.RateInstance(ctx, model.FolderId, model.ReleaseId, model.InstanceId, rating); err != nil {
+			t.queueUpdateDraw(func() {
+				// Only roll back if no other rating was set in the meantime
+				if index := t.collectionIndex(model.InstanceId); index >= 0 && t.Collection[index].Rating == rating {
+					t.setCollectionRating(model.InstanceId, model.Rating)
+				}
+			})
+			t.showError(err)
+			return
+		}
+		t.showMessage(fmt.Sprintf("✓ Rated %s %s", model.Title, ratingStars(rating)))
+	}()
+}
+
+// setCollectionRating updates the rating of a release instance and its card
+func (t *TUI) setCollectionRating(instanceId int, rating uint8) {
+	if index := t.collectionIndex(instanceId); index >= 0 {
+		t.Collection[index].Rating = rating
+		t.refreshReleaseCard(t.collectionCards[index], t.Collection[index])
+	}
+}
+
+// openFieldsForm loads the custom fields and lets the user edit them for a release instance
+func (t *TUI) openFieldsForm(model dto.ReleaseModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	fields, err := t.Client.GetCollectionFields(ctx)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+
+	t.queueUpdateDraw(func() {
+		form := tview.NewForm()
+		height := 4
+		for _, field := range fields {
+			value := model.Field(field.Id)
+			switch field.Type {
+			case dto.FieldTypeDropdown:
+				// Only the field's options can be picked, the empty option clears the field
+				options := append([]string{""}, field.Options...)
+				form.AddDropDown(field.Name, options, slices.Index(options, value), nil)
+				height += 2
+			default:
+				lines := max(field.Lines, 1)
+				form.AddTextArea(field.Name, value, 0, lines, 0, nil)
+				height += lines + 1
+			}
+		}
+
+		form.AddButton("Save", func() {
+			changes := make(map[int]string)
+			for i, field := range fields {
+				var value string
+				switch item := form.GetFormItem(i).(type) {
+				case *tview.DropDown:
+					index, option := item.GetCurrentOption()
+					if index < 0 {
+						// A value that is not one of the options is kept unless another one is picked
+						continue
+					}
+					value = option
+				case *tview.TextArea:
+					value = item.GetText()
+				}
+				if value != model.Field(field.Id) {
+					changes[field.Id] = value
+				}
+			}
+			t.closePage("dialog")
+			if len(changes) > 0 {
+				go t.saveFields(model, fields, changes)
+			}
+		}).AddButton("Cancel", func() { t.closePage("dialog") })
+		form.SetBorder(true).SetTitle(fmt.Sprintf("Edit %s", model.Title))
+		form.SetCancelFunc(func() { t.closePage("dialog") })
+
+		t.openDialog(form, 70, min(height, 40))
+	})
+}
+
+// saveFields posts the changed custom fields of a release instance and refreshes its card
+func (t *TUI) saveFields(model dto.ReleaseModel, fields []dto.CollectionFieldModel, changes map[int]string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	for _, field := range fields {
+		value, changed := changes[field.Id]
+		if !changed {
+			continue
+		}
+		if err := t.Client.SetFieldValue(ctx, model.FolderId, model.ReleaseId, model.InstanceId, field, value); err != nil {
+			t.showError(err)
+			return
+		}
+		t.awaitUpdateDraw(func() {
+			if index := t.collectionIndex(model.InstanceId); index >= 0 {
+				t.Collection[index].SetField(field, value)
+				t.refreshReleaseCard(t.collectionCards[index], t.Collection[index])
+			}
+		})
+	}
+	t.showMessage(fmt.Sprintf("✓ Saved %s", model.Title))
+}
+
+// collectionIndex returns the position of a release instance in Collection or -1
+func (t *TUI) collectionIndex(instanceId int) int {
+	for i, model := range t.Collection {
+		if model.InstanceId == instanceId {
+			return i
+		}
+	}
+	return -1
+}
+
+// insertCollectionRelease adds the card of a new copy to the collection. Must run on the UI goroutine.
+func (t *TUI) insertCollectionRelease(model dto.ReleaseModel) {
+	card, thumb := t.createReleaseCard(model)
+	card.SetTitle(model.Title)
+	card.SetInputCapture(t.collectionCardInput(model))
+	t.Collection = append(t.Collection, model)
+	t.collectionCards = append(t.collectionCards, card)
+	t.applyFolderFilter()
+	go t.loadThumbnail(thumb, model.ThumbUrl)
+}
+
+// addCollectionInstance adds a copy of a release to a folder and appends a card for it
+func (t *TUI) addCollectionInstance(model dto.ReleaseModel, folder dto.FolderModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	instanceId, err := t.Client.AddToFolder(ctx, folder.Id, model.ReleaseId)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+
+	// The new copy only shares the release data, not the notes of the original
+	added := model
+	added.InstanceId = instanceId
+	added.FolderId = folder.Id
+	added.Rating = 0
+	added.MediaCondition, added.SleeveCondition, added.Note = "", "", ""
+	added.Fields = make([]dto.FieldValueModel, len(model.Fields))
+	for i, field := range model.Fields {
+		field.Value = ""
+		added.Fields[i] = field
+	}
+
+	t.awaitUpdateDraw(func() { t.insertCollectionRelease(added) })
+	t.showMessage(fmt.Sprintf("✓ Added a copy of %s to %s", added.Title, folder.Name))
+	t.refreshFolders(ctx)
+}
+
+// moveCollectionInstance moves a copy of a release to another folder and refreshes its card
+func (t *TUI) moveCollectionInstance(model dto.ReleaseModel, folder dto.FolderModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := t.Client.MoveInstance(ctx, model.FolderId, model.ReleaseId, model.InstanceId, folder.Id); err != nil {
+		t.showError(err)
+		return
+	}
+
+	t.awaitUpdateDraw(func() {
+		if index := t.collectionIndex(model.InstanceId); index >= 0 {
+			t.Collection[index].FolderId = folder.Id
+			t.refreshReleaseCard(t.collectionCards[index], t.Collection[index])
+			t.applyFolderFilter()
+		}
+	})
+	t.showMessage(fmt.Sprintf("✓ Moved %s to %s", model.Title, folder.Name))
+	t.refreshFolders(ctx)
+}
+
+// deleteCollectionInstance removes a copy of a release from the collection and drops its card
+func (t *TUI) deleteCollectionInstance(model dto.ReleaseModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := t.Client.DeleteInstance(ctx, model.FolderId, model.ReleaseId, model.InstanceId); err != nil {
+		t.showError(err)
+		return
+	}
+
+	t.awaitUpdateDraw(func() {
+		if index := t.collectionIndex(model.InstanceId); index >= 0 {
+			t.Collection = slices.Delete(t.Collection, index, index+1)
+			t.collectionCards = slices.Delete(t.collectionCards, index, index+1)
+			t.applyFolderFilter()
+			if t.PreviewPosition[0]+t.PreviewPosition[1] >= len(t.CollectionPrims) {
+				t.PreviewPosition = [2]int{0, 0}
+			}
+		}
+	})
+	t.showMessage(fmt.Sprintf("✓ Removed %s from your collection", model.Title))
+	t.refreshFolders(ctx)
+}
+
+// refreshFolders reloads the folder tree after the folder counts changed and redraws the preview
+func (t *TUI) refreshFolders(ctx context.Context) {
+	folders, err := t.Client.GetFolders(ctx)
+	if err != nil {
+		t.showWarning(fmt.Sprintf("Failed to refresh folders: %v", err))
+		folders = t.Folders
+	}
+	t.setFolders(folders)
+	t.DrawPreviewGrid()
+	t.queueUpdateDraw(func() {
+		if front, _ := t.Pages.GetFrontPage(); front == "main" && t.SelectedSource == client.CollectionSource {
+			t.App.SetFocus(t.Preview)
+			t.handlePreviewNavigation(tcell.KeyEnd)
+		}
+	})
+}
@@ -2,19 +2,38 @@ package tui
 
 import (
 	"github.com/gdamore/tcell/v2"
-	"github.com/rivo/tview"
 	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
 )
 
-func (t *TUI) sourceSelected(_ int, _ string, _ string, shortcut rune) {
+func (t *TUI) sourceSelected(index int, _ string, _ string, shortcut rune) {
 	switch shortcut {
 	case '0':
 		t.SelectedSource = client.CollectionSource
+		t.SelectedFolder = client.AllFolderId
+		t.applyFolderFilter()
+	case 0:
+		// Folder items have no shortcut
+		folder, ok := t.folderAt(index)
+		if !ok {
+			return
+		}
+		t.SelectedSource = client.CollectionSource
+		t.SelectedFolder = folder.Id
+		t.applyFolderFilter()
 	case '1':
 		t.SelectedSource = client.WishlistSource
 	case '2':
 		t.SelectedSource = client.OrdersSource
-	case 'q':
+	case '3':
+		t.SelectedSource = client.InventorySource
+		// The inventory is only loaded when it is first shown
+		if !t.inventoryLoaded {
+			go t.loadInventory(t.InventoryQuery)
+		}
+	case '4':
+		t.SelectedSource = client.ListsSource
+	case 's', 'q':
 		return
 	}
 
@@ -39,6 +58,12 @@ func (t *TUI) focusOnPreview(src client.DataSource) func() {
 				if len(t.OrderPrims) > 0 {
 					t.App.SetFocus(t.OrderPrims[0])
 				}
+			case client.InventorySource:
+				t.App.SetFocus(t.InventoryTable)
+			case client.ListsSource:
+				if len(t.ListPrims) > 0 {
+					t.App.SetFocus(t.ListPrims[0])
+				}
 			}
 		})
 	}
@@ -66,6 +91,12 @@ func (t *TUI) setUpInputCaptures() {
 				if len(t.OrderPrims) > 0 {
 					t.App.SetFocus(t.OrderPrims[primIndex])
 				}
+			case client.InventorySource:
+				t.App.SetFocus(t.InventoryTable)
+			case client.ListsSource:
+				if len(t.ListPrims) > 0 {
+					t.App.SetFocus(t.ListPrims[primIndex])
+				}
 			}
 
 		// preview navigation
@@ -127,26 +158,43 @@ func (t *TUI) handlePreviewNavigation(k tcell.Key) {
 				overstep = true
 			}
 		}
+	case client.ListsSource:
+		if len(t.ListPrims) > 0 {
+			if primIndex < len(t.ListPrims) {
+				t.App.SetFocus(t.ListPrims[primIndex])
+			} else {
+				overstep = true
+			}
+		}
+	case client.InventorySource:
+		// The table handles its own navigation
+		t.App.SetFocus(t.InventoryTable)
+		return
 	}
 	if !overstep {
 		t.PreviewPosition = potentialPosition
 	}
 }
 
-func (t *TUI) openReleaseModal(key *tcell.EventKey) *tcell.EventKey {
-	switch key.Key() {
-	case tcell.KeyEnter:
-		infobox := tview.NewModal().
-			AddButtons([]string{"Close"}).
-			SetDoneFunc(func(_ int, _ string) {
-				t.Pages.SwitchToPage("main")
-				t.App.SetFocus(t.Preview)
-				t.handlePreviewNavigation(tcell.KeyEnd)
-			}).
-			SetText("Lorem Ipsum Is A Pain")
-
-		t.Pages.AddAndSwitchToPage("modal", infobox, true)
-
+// releaseCardInput handles the key bindings of a focused release card
+func (t *TUI) releaseCardInput(model dto.ReleaseModel) func(*tcell.EventKey) *tcell.EventKey {
+	return func(key *tcell.EventKey) *tcell.EventKey {
+		switch key.Key() {
+		case tcell.KeyEnter:
+			t.openReleaseDetail(model.ReleaseId)
+			return nil
+		}
+		switch key.Rune() {
+		case 'm':
+			t.openMasterBrowser(model.MasterId)
+			return nil
+		case 'a':
+			t.openArtistPage(model.ArtistId)
+			return nil
+		case 'l':
+			t.openLabelPage(model.LabelId)
+			return nil
+		}
+		return key
 	}
-	return key
 }
@@ -0,0 +1,361 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// allListingStatuses is the filter entry that disables status filtering.
+const allListingStatuses = "All statuses"
+
+// createInventoryTable creates the listing table shown in the preview for the inventory source
+func (t *TUI) createInventoryTable() *tview.Table {
+	table := tview.NewTable().SetSelectable(true, false).SetFixed(1, 0)
+	table.SetSelectedFunc(func(row, _ int) {
+		if listing, ok := t.selectedListing(); ok {
+			t.openEditListing(listing)
+		}
+	})
+	table.SetInputCapture(t.inventoryInput)
+	return table
+}
+
+// inventoryInput handles the key bindings of the inventory table
+func (t *TUI) inventoryInput(key *tcell.EventKey) *tcell.EventKey {
+	switch key.Rune() {
+	case 'n':
+		if t.Inventory.Page < t.Inventory.Pages {
+			t.InventoryQuery.Page++
+			go t.loadInventory(t.InventoryQuery)
+		}
+		return nil
+	case 'p':
+		if t.InventoryQuery.Page > 1 {
+			t.InventoryQuery.Page--
+			go t.loadInventory(t.InventoryQuery)
+		}
+		return nil
+	case 's':
+		next := (slices.Index(client.InventorySorts, t.InventoryQuery.Sort) + 1) % len(client.InventorySorts)
+		t.InventoryQuery.Sort = client.InventorySorts[next]
+		t.InventoryQuery.Page = 1
+		go t.loadInventory(t.InventoryQuery)
+		return nil
+	case 'o':
+		if t.InventoryQuery.SortOrder == "asc" {
+			t.InventoryQuery.SortOrder = "desc"
+		} else {
+			t.InventoryQuery.SortOrder = "asc"
+		}
+		t.InventoryQuery.Page = 1
+		go t.loadInventory(t.InventoryQuery)
+		return nil
+	case 'f':
+		t.openInventoryFilter()
+		return nil
+	case ' ':
+		if listing, ok := t.selectedListing(); ok {
+			t.toggleListingSelection(listing)
+			row, _ := t.InventoryTable.GetSelection()
+			t.renderInventory()
+			t.InventoryTable.Select(min(row+1, len(t.Inventory.Listings)), 0)
+		}
+		return nil
+	case '*':
+		t.toggleInventoryPageSelection()
+		t.renderInventory()
+		return nil
+	case 'r':
+		t.openRepriceForm()
+		return nil
+	case 'j':
+		t.openJobsPage()
+		return nil
+	case 'a':
+		t.openCreateListing(client.ListingUpdate{Status: dto.ListingStatusDraft})
+		return nil
+	case 'e':
+		if listing, ok := t.selectedListing(); ok {
+			t.openEditListing(listing)
+		}
+		return nil
+	case 'x':
+		if listing, ok := t.selectedListing(); ok {
+			t.confirm(fmt.Sprintf("Delete the listing of %s?", listing.Description), func() {
+				go t.deleteListing(listing)
+			})
+		}
+		return nil
+	}
+	return key
+}
+
+// selectedListing returns the listing under the table cursor
+func (t *TUI) selectedListing() (dto.ListingModel, bool) {
+	row, _ := t.InventoryTable.GetSelection()
+	if row <= 0 || row > len(t.Inventory.Listings) {
+		return dto.ListingModel{}, false
+	}
+	return t.Inventory.Listings[row-1], true
+}
+
+// toggleListingSelection adds or removes a listing from the selection used by bulk operations
+func (t *TUI) toggleListingSelection(listing dto.ListingModel) {
+	if _, ok := t.inventorySelected[listing.Id]; ok {
+		delete(t.inventorySelected, listing.Id)
+	} else {
+		t.inventorySelected[listing.Id] = listing
+	}
+	t.Preview.SetTitle(t.inventoryTitle())
+}
+
+// toggleInventoryPageSelection selects every listing of the page, or clears them if all are selected
+func (t *TUI) toggleInventoryPageSelection() {
+	all := true
+	for _, listing := range t.Inventory.Listings {
+		if _, ok := t.inventorySelected[listing.Id]; !ok {
+			all = false
+		}
+	}
+	for _, listing := range t.Inventory.Listings {
+		if all {
+			delete(t.inventorySelected, listing.Id)
+		} else {
+			t.inventorySelected[listing.Id] = listing
+		}
+	}
+	t.Preview.SetTitle(t.inventoryTitle())
+}
+
+// loadInventory fetches a page of the inventory and redraws the table. The query is
+// passed by value since the key bindings keep changing InventoryQuery meanwhile.
+func (t *TUI) loadInventory(query client.InventoryQuery) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	t.showMessage(fmt.Sprintf("Loading inventory page %d...", query.Page))
+	inventory, err := t.Client.GetInventory(ctx, query)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.queueUpdateDraw(func() {
+		t.Inventory = inventory
+		t.inventoryLoaded = true
+		for _, listing := range inventory.Listings {
+			if _, ok := t.inventorySelected[listing.Id]; ok {
+				t.inventorySelected[listing.Id] = listing
+			}
+		}
+		t.renderInventory()
+		t.drawPreviewGrid()
+	})
+}
+
+// reloadInventory fetches the current inventory page again if it was ever shown.
+// It must be called from a background goroutine.
+func (t *TUI) reloadInventory() {
+	var query client.InventoryQuery
+	loaded := false
+	t.awaitUpdateDraw(func() { query, loaded = t.InventoryQuery, t.inventoryLoaded })
+	if loaded {
+		t.loadInventory(query)
+	}
+}
+
+// renderInventory fills the listing table
+func (t *TUI) renderInventory() {
+	table := t.InventoryTable
+	row, _ := table.GetSelection()
+
+	table.Clear()
+	for col, title := range []string{"", "Status", "Release", "Cat#", "Condition", "Sleeve", "Price", "Location", "Comments", "Listed"} {
+		table.SetCell(0, col, tview.NewTableCell(title).SetSelectable(false).SetTextColor(tcell.ColorYellow))
+	}
+
+	for i, listing := range t.Inventory.Listings {
+		color := tcell.ColorWhite
+		switch listing.Status {
+		case dto.ListingStatusForSale:
+			color = tcell.ColorGreen
+		case dto.ListingStatusDraft:
+			color = tcell.ColorGray
+		case dto.ListingStatusSold:
+			color = tcell.ColorBlue
+		case dto.ListingStatusExpired, dto.ListingStatusSuspended, dto.ListingStatusViolation:
+			color = tcell.ColorRed
+		}
+
+		listed := ""
+		if !listing.Posted.IsZero() {
+			listed = listing.Posted.Format("2006-01-02")
+		}
+		mark := ""
+		if _, ok := t.inventorySelected[listing.Id]; ok {
+			mark = "✓"
+		}
+		for col, text := range []string{
+			mark,
+			listing.Status,
+			listing.Description,
+			listing.CatNo,
+			listing.Condition,
+			listing.SleeveCondition,
+			listing.Price.String(),
+			listing.Location,
+			strings.ReplaceAll(listing.Comments, "\n", " "),
+			listed,
+		} {
+			table.SetCell(i+1, col, tview.NewTableCell(tview.Escape(text)).SetTextColor(color).SetMaxWidth(40))
+		}
+	}
+	table.Select(min(max(row, 1), len(t.Inventory.Listings)), 0)
+}
+
+// inventoryTitle describes the current inventory page, sort and filter
+func (t *TUI) inventoryTitle() string {
+	if !t.inventoryLoaded {
+		return fmt.Sprintf("%s · Inventory · loading...", PreviewTitle)
+	}
+	status := t.InventoryQuery.Status
+	if status == "" {
+		status = allListingStatuses
+	}
+	title := fmt.Sprintf("%s · Inventory page %d/%d · %d listings · sort %s %s · %s",
+		PreviewTitle, t.Inventory.Page, t.Inventory.Pages, t.Inventory.TotalItems,
+		t.InventoryQuery.Sort, t.InventoryQuery.SortOrder, status)
+	if len(t.inventorySelected) > 0 {
+		title = fmt.Sprintf("%s · %d selected", title, len(t.inventorySelected))
+	}
+	return title
+}
+
+// openInventoryFilter shows a picker for the listing status filter
+func (t *TUI) openInventoryFilter() {
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).SetTitle("Filter listings by status")
+	for _, status := range append([]string{allListingStatuses}, dto.ListingStatuses...) {
+		status := status
+		list.AddItem(status, "", 0, func() {
+			t.InventoryQuery.Status = ""
+			if status != allListingStatuses {
+				t.InventoryQuery.Status = status
+			}
+			t.InventoryQuery.Page = 1
+			t.closePage("dialog")
+			go t.loadInventory(t.InventoryQuery)
+		})
+	}
+	list.SetDoneFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(list, 40, len(dto.ListingStatuses)+3)
+}
+
+// openCreateListing asks for the details of a new listing
+func (t *TUI) openCreateListing(update client.ListingUpdate) {
+	t.openListingForm("New listing", update, true, t.createListing)
+}
+
+// createListing creates a listing from a listing form
+func (t *TUI) createListing(ctx context.Context, update client.ListingUpdate) error {
+	id, err := t.Client.CreateListing(ctx, update)
+	if err == nil {
+		t.showMessage(fmt.Sprintf("✓ Created listing %d", id))
+	}
+	return err
+}
+
+// openEditListing asks for the new details of a listing
+func (t *TUI) openEditListing(listing dto.ListingModel) {
+	t.openListingForm(fmt.Sprintf("Edit listing %d", listing.Id), client.ListingUpdateFrom(listing), false, func(ctx context.Context, update client.ListingUpdate) error {
+		err := t.Client.EditListing(ctx, listing.Id, update)
+		if err == nil {
+			t.showMessage(fmt.Sprintf("✓ Saved listing %d", listing.Id))
+		}
+		return err
+	})
+}
+
+// openListingForm shows a listing form prefilled with update and passes the validated result to save
+func (t *TUI) openListingForm(title string, update client.ListingUpdate, editRelease bool, save func(context.Context, client.ListingUpdate) error) {
+	sleeves := append([]string{""}, dto.SleeveConditions...)
+	price := ""
+	if update.Price > 0 {
+		price = strconv.FormatFloat(update.Price, 'f', 2, 64)
+	}
+	releaseId := ""
+	if update.ReleaseId > 0 {
+		releaseId = strconv.Itoa(update.ReleaseId)
+	}
+
+	form := tview.NewForm()
+	if editRelease {
+		form.AddInputField("Release ID", releaseId, 12, tview.InputFieldInteger, nil)
+	} else {
+		form.AddTextView("Release ID", releaseId, 12, 1, false, false)
+	}
+	form.
+		AddDropDown("Condition", dto.Conditions, slices.Index(dto.Conditions, update.Condition), nil).
+		AddDropDown("Sleeve", sleeves, max(slices.Index(sleeves, update.SleeveCondition), 0), nil).
+		AddInputField("Price", price, 12, acceptPrice, nil).
+		AddDropDown("Status", client.EditableListingStatuses, slices.Index(client.EditableListingStatuses, update.Status), nil).
+		AddCheckbox("Allow offers", update.AllowOffers, nil).
+		AddInputField("Location", update.Location, 30, nil, nil).
+		AddTextArea("Comments", update.Comments, 0, 3, 0, nil)
+
+	form.AddButton("Save", func() {
+		if editRelease {
+			update.ReleaseId, _ = strconv.Atoi(form.GetFormItemByLabel("Release ID").(*tview.InputField).GetText())
+		}
+		_, update.Condition = form.GetFormItemByLabel("Condition").(*tview.DropDown).GetCurrentOption()
+		_, update.SleeveCondition = form.GetFormItemByLabel("Sleeve").(*tview.DropDown).GetCurrentOption()
+		update.Price, _ = strconv.ParseFloat(form.GetFormItemByLabel("Price").(*tview.InputField).GetText(), 64)
+		_, update.Status = form.GetFormItemByLabel("Status").(*tview.DropDown).GetCurrentOption()
+		update.AllowOffers = form.GetFormItemByLabel("Allow offers").(*tview.Checkbox).IsChecked()
+		update.Location = strings.TrimSpace(form.GetFormItemByLabel("Location").(*tview.InputField).GetText())
+		update.Comments = strings.TrimSpace(form.GetFormItemByLabel("Comments").(*tview.TextArea).GetText())
+
+		// Keep the form open so mistakes can be fixed
+		if err := client.ValidateListing(update); err != nil {
+			t.showError(err)
+			return
+		}
+		t.closePage("dialog")
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			if err := save(ctx, update); err != nil {
+				t.showError(err)
+				return
+			}
+			t.reloadInventory()
+		}()
+	}).AddButton("Cancel", func() { t.closePage("dialog") })
+	form.SetBorder(true).SetTitle(title)
+	form.SetCancelFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(form, 70, 23)
+}
+
+// deleteListing removes a listing from the marketplace and reloads the inventory page
+func (t *TUI) deleteListing(listing dto.ListingModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := t.Client.DeleteListing(ctx, listing.Id); err != nil {
+		t.showError(err)
+		return
+	}
+	t.showMessage(fmt.Sprintf("✓ Deleted listing %d", listing.Id))
+	t.reloadInventory()
+}
@@ -0,0 +1,248 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// jobsPage is the name of the inventory jobs page.
+const jobsPage = "jobs"
+
+// jobsPanel is the inventory jobs page while it is open
+type jobsPanel struct {
+	header *tview.TextView
+	table  *tview.Table
+}
+
+// openJobsPage shows the inventory exports and uploads and lets the user start new ones
+func (t *TUI) openJobsPage() {
+	panel := &jobsPanel{
+		header: tview.NewTextView(),
+		table:  tview.NewTable().SetSelectable(true, false).SetFixed(1, 0),
+	}
+	panel.header.SetText("Export [ e ] · Download export [ d ] · Upload CSV [ u ] · Refresh [ r ] · Close [ Esc ]")
+	panel.table.SetBorder(true).SetTitle("Inventory jobs").SetTitleAlign(tview.AlignLeft)
+
+	page := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(panel.header, 1, 0, false).
+		AddItem(panel.table, 0, 1, true)
+	page.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyEscape {
+			t.jobsPanel = nil
+			t.closePage(jobsPage)
+			return nil
+		}
+		switch key.Rune() {
+		case 'e':
+			go t.requestExport()
+			return nil
+		case 'd':
+			if job, ok := t.selectedJob(); ok {
+				if job.Kind != dto.JobKindExport || job.Status != dto.JobStatusSuccess {
+					t.showWarning("Only finished exports can be downloaded")
+					return nil
+				}
+				go t.downloadExport(job)
+			}
+			return nil
+		case 'u':
+			t.openUploadForm()
+			return nil
+		case 'r':
+			go t.loadJobs()
+			return nil
+		}
+		return key
+	})
+
+	t.jobsPanel = panel
+	t.renderJobs()
+	t.openPage(jobsPage, page)
+	go t.loadJobs()
+}
+
+// selectedJob returns the job under the table cursor
+func (t *TUI) selectedJob() (dto.InventoryJobModel, bool) {
+	if t.jobsPanel == nil {
+		return dto.InventoryJobModel{}, false
+	}
+	row, _ := t.jobsPanel.table.GetSelection()
+	if row <= 0 || row > len(t.Jobs) {
+		return dto.InventoryJobModel{}, false
+	}
+	return t.Jobs[row-1], true
+}
+
+// loadJobs fetches the recent exports and uploads
+func (t *TUI) loadJobs() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Either list is shown even if the other one can't be fetched
+	exports, exportsErr := t.Client.GetExports(ctx)
+	uploads, uploadsErr := t.Client.GetUploads(ctx)
+	if err := errors.Join(exportsErr, uploadsErr); err != nil {
+		t.showError(err)
+		if exportsErr != nil && uploadsErr != nil {
+			return
+		}
+	}
+
+	jobs := append(exports, uploads...)
+	slices.SortStableFunc(jobs, func(a, b dto.InventoryJobModel) int {
+		return b.Created.Compare(a.Created)
+	})
+	t.queueUpdateDraw(func() {
+		t.Jobs = jobs
+		t.renderJobs()
+	})
+}
+
+// trackJob stores the latest status of a job and redraws the panel if it is open
+func (t *TUI) trackJob(job dto.InventoryJobModel) {
+	t.queueUpdateDraw(func() {
+		index := slices.IndexFunc(t.Jobs, func(tracked dto.InventoryJobModel) bool {
+			return tracked.Id == job.Id && (tracked.Kind == dto.JobKindExport) == (job.Kind == dto.JobKindExport)
+		})
+		if index < 0 {
+			t.Jobs = append([]dto.InventoryJobModel{job}, t.Jobs...)
+		} else {
+			t.Jobs[index] = job
+		}
+		t.renderJobs()
+	})
+}
+
+// renderJobs fills the job table of the open panel
+func (t *TUI) renderJobs() {
+	if t.jobsPanel == nil {
+		return
+	}
+	table := t.jobsPanel.table
+	row, _ := table.GetSelection()
+
+	table.Clear()
+	for col, title := range []string{"Kind", "ID", "Status", "File", "Created", "Finished", "Results"} {
+		table.SetCell(0, col, tview.NewTableCell(title).SetSelectable(false).SetTextColor(tcell.ColorYellow))
+	}
+	for i, job := range t.Jobs {
+		color := tcell.ColorWhite
+		switch job.Status {
+		case dto.JobStatusSuccess:
+			color = tcell.ColorGreen
+		case dto.JobStatusFailure:
+			color = tcell.ColorRed
+		}
+
+		finished := ""
+		if !job.Finished.IsZero() {
+			finished = job.Finished.Format("2006-01-02 15:04")
+		}
+		for col, text := range []string{
+			job.Kind,
+			strconv.Itoa(job.Id),
+			job.Status,
+			job.Filename,
+			job.Created.Format("2006-01-02 15:04"),
+			finished,
+			strings.ReplaceAll(job.Results, "\n", " "),
+		} {
+			table.SetCell(i+1, col, tview.NewTableCell(tview.Escape(text)).SetTextColor(color).SetMaxWidth(50))
+		}
+	}
+	table.Select(min(max(row, 1), len(t.Jobs)), 0)
+}
+
+// pollInterval is how often running jobs are checked
+func (t *TUI) pollInterval() time.Duration {
+	return t.Config.Inventory.PollInterval()
+}
+
+// requestExport starts an inventory export, waits for it and downloads the CSV
+func (t *TUI) requestExport() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	id, err := t.Client.RequestExport(ctx)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.showMessage(fmt.Sprintf("Export %d requested, waiting for Discogs...", id))
+
+	job, err := client.PollJob(ctx, t.pollInterval(), func(ctx context.Context) (dto.InventoryJobModel, error) {
+		return t.Client.GetExport(ctx, id)
+	}, t.trackJob)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.downloadExport(job)
+}
+
+// downloadExport saves the CSV of a finished export to the configured directory
+func (t *TUI) downloadExport(job dto.InventoryJobModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	target, err := t.Client.DownloadExport(ctx, job, t.Config.Inventory.ExportDirectory())
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.showMessage(fmt.Sprintf("✓ Export %d saved to %s", job.Id, target))
+}
+
+// openUploadForm asks for a CSV file and the kind of change it contains
+func (t *TUI) openUploadForm() {
+	form := tview.NewForm().
+		AddDropDown("Kind", client.UploadKinds, 0, nil).
+		AddInputField("CSV file", "", 40, nil, nil)
+	form.AddButton("Upload", func() {
+		_, kind := form.GetFormItemByLabel("Kind").(*tview.DropDown).GetCurrentOption()
+		path := strings.TrimSpace(form.GetFormItemByLabel("CSV file").(*tview.InputField).GetText())
+		if path == "" {
+			t.showWarning("Enter the path of a CSV file")
+			return
+		}
+		t.closePage("dialog")
+		go t.uploadInventory(kind, path)
+	}).AddButton("Cancel", func() { t.closePage("dialog") })
+	form.SetBorder(true).SetTitle("Upload inventory CSV")
+	form.SetCancelFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(form, 60, 9)
+}
+
+// uploadInventory uploads a CSV and tracks the upload until Discogs processed it
+func (t *TUI) uploadInventory(kind, path string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	id, err := t.Client.UploadInventory(ctx, kind, path)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.showMessage(fmt.Sprintf("Upload %d sent, waiting for Discogs...", id))
+
+	job, err := client.PollJob(ctx, t.pollInterval(), func(ctx context.Context) (dto.InventoryJobModel, error) {
+		return t.Client.GetUpload(ctx, id)
+	}, t.trackJob)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.showMessage(fmt.Sprintf("✓ Upload %d processed: %s", job.Id, job.Results))
+	t.reloadInventory()
+}
@@ -0,0 +1,237 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// labelPage is the name of the label page.
+const labelPage = "label"
+
+// labelCatalogPages is the number of catalog pages fetched at once. Big labels have hundreds
+// of pages, so more are only fetched when the end of the table is reached to spare the request budget.
+const labelCatalogPages = 3
+
+// labelBrowser holds the state of the label page while its catalog is fetched
+type labelBrowser struct {
+	label    dto.LabelModel
+	releases []dto.LabelReleaseModel
+	page     int
+	total    int
+	done     bool
+	loading  bool
+
+	header *tview.TextView
+	table  *tview.Table
+}
+
+// openLabelPage opens the profile and catalog of a label with the completion of our collection.
+// The catalog is fetched a few pages at a time, so big labels fill in as the table is scrolled.
+func (t *TUI) openLabelPage(labelId int) {
+	if labelId == 0 {
+		t.showWarning("No label linked to this release")
+		return
+	}
+
+	b := &labelBrowser{
+		label:  dto.LabelModel{Id: labelId},
+		header: tview.NewTextView().SetScrollable(true).SetWrap(true).SetWordWrap(true),
+		table:  tview.NewTable().SetSelectable(true, false).SetFixed(1, 0),
+	}
+	b.header.SetBorder(true).SetTitle("Label").SetTitleAlign(tview.AlignLeft)
+	b.header.SetText("Loading label...")
+	b.table.SetBorder(true).SetTitle("Catalog · Open [ Enter ] · Close [ Esc ]").SetTitleAlign(tview.AlignLeft)
+	b.table.SetSelectedFunc(func(row, _ int) {
+		if row > 0 && row <= len(b.releases) {
+			t.openReleaseDetail(b.releases[row-1].ReleaseId)
+		}
+	})
+
+	// Closing the page stops fetching the catalog
+	ctx, cancel := context.WithCancel(context.Background())
+	b.table.SetSelectionChangedFunc(func(row, _ int) {
+		if row == len(b.releases) && !b.done && !b.loading {
+			b.loading = true
+			t.renderLabelHeader(b)
+			go t.loadLabelPages(ctx, b, b.page+1)
+		}
+	})
+	page := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(b.header, 0, 1, false).
+		AddItem(b.table, 0, 2, true)
+	page.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		switch key.Key() {
+		case tcell.KeyEscape:
+			cancel()
+			t.closePage(labelPage)
+			return nil
+		case tcell.KeyPgUp, tcell.KeyPgDn:
+			b.header.InputHandler()(key, func(tview.Primitive) {})
+			return nil
+		}
+		return key
+	})
+
+	b.loading = true
+	t.openPage(labelPage, page)
+	go t.loadLabelCatalog(ctx, b)
+}
+
+// loadLabelCatalog fetches the label and then the first pages of its catalog
+func (t *TUI) loadLabelCatalog(ctx context.Context, b *labelBrowser) {
+	labelCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	label, err := t.Client.GetLabel(labelCtx, b.label.Id)
+	cancel()
+	if err != nil {
+		if ctx.Err() == nil {
+			t.showError(err)
+		}
+		return
+	}
+	t.awaitUpdateDraw(func() {
+		b.label = label
+		b.header.SetTitle(fmt.Sprintf("%s [ PgUp/PgDn ]", label.Name))
+	})
+	t.loadLabelPages(ctx, b, 1)
+}
+
+// loadLabelPages fetches up to labelCatalogPages catalog pages starting at first,
+// redrawing the table and the completion after every page
+func (t *TUI) loadLabelPages(ctx context.Context, b *labelBrowser, first int) {
+	defer t.queueUpdateDraw(func() {
+		b.loading = false
+		t.renderLabelHeader(b)
+	})
+
+	progress := t.reportProgress("label catalog")
+	for page := first; page < first+labelCatalogPages; page++ {
+		pageCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		result, err := t.Client.GetLabelReleases(pageCtx, b.label.Id, page)
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil {
+				t.showError(err)
+			}
+			return
+		}
+
+		done := result.Page >= result.Pages
+		fetched := 0
+		t.awaitUpdateDraw(func() {
+			b.releases = append(b.releases, result.Releases...)
+			dto.SortLabelReleases(b.releases)
+			b.page = page
+			b.total = result.TotalItems
+			b.done = done
+			fetched = len(b.releases)
+			t.renderLabelCatalog(b)
+		})
+		progress(fetched, result.TotalItems)
+		if done {
+			return
+		}
+	}
+}
+
+// renderLabelCatalog fills the catalog table, keeping the selected release, and updates the completion
+func (t *TUI) renderLabelCatalog(b *labelBrowser) {
+	own := t.ownership()
+
+	selected := 0
+	if row, _ := b.table.GetSelection(); row > 0 {
+		if cell := b.table.GetCell(row, 0); cell.GetReference() != nil {
+			selected = cell.GetReference().(int)
+		}
+	}
+
+	b.table.Clear()
+	for col, title := range []string{"Cat#", "Artist", "Title", "Format", "Year", "Status"} {
+		b.table.SetCell(0, col, tview.NewTableCell(title).SetSelectable(false).SetTextColor(tcell.ColorYellow))
+	}
+	selectedRow := 0
+	for i, release := range b.releases {
+		color, status := tcell.ColorGray, "Missing"
+		switch {
+		case release.InCollection || own.owned[release.ReleaseId]:
+			color, status = tcell.ColorGreen, "Owned"
+		case release.InWantlist || own.wanted[release.ReleaseId]:
+			color, status = tcell.ColorOrange, "Wanted"
+		}
+
+		row := i + 1
+		if release.ReleaseId == selected {
+			selectedRow = row
+		}
+		for col, text := range []string{
+			release.CatNo,
+			release.Artist,
+			release.Title,
+			release.Format,
+			fmt.Sprint(release.Year),
+			status,
+		} {
+			b.table.SetCell(row, col, tview.NewTableCell(tview.Escape(text)).SetTextColor(color).SetMaxWidth(40).SetReference(release.ReleaseId))
+		}
+	}
+	if selectedRow > 0 {
+		b.table.Select(selectedRow, 0)
+	} else {
+		b.table.Select(1, 0).ScrollToBeginning()
+	}
+
+	t.renderLabelHeader(b)
+}
+
+// renderLabelHeader updates the label profile and the completion, keeping the scroll position
+func (t *TUI) renderLabelHeader(b *labelBrowser) {
+	own := t.ownership()
+	owned := 0
+	for _, release := range b.releases {
+		if release.InCollection || own.owned[release.ReleaseId] {
+			owned++
+		}
+	}
+	row, col := b.header.GetScrollOffset()
+	b.header.SetText(labelHeaderText(b.label, owned, len(b.releases), b.total, b.done, b.loading)).ScrollTo(row, col)
+}
+
+// labelHeaderText renders the label profile and the completion of our collection
+func labelHeaderText(label dto.LabelModel, owned, fetched, total int, done, loading bool) string {
+	var b strings.Builder
+	completion := 0.0
+	if fetched > 0 {
+		completion = float64(owned) / float64(fetched) * 100
+	}
+	switch {
+	case done:
+		fmt.Fprintf(&b, "%s\nCompletion: %d/%d releases (%.1f%%)\n", label.Name, owned, fetched, completion)
+	case loading:
+		fmt.Fprintf(&b, "%s\nCompletion so far: %d/%d releases (%.1f%%) · fetched %d of %d, loading...\n",
+			label.Name, owned, fetched, completion, fetched, total)
+	default:
+		fmt.Fprintf(&b, "%s\nCompletion so far: %d/%d releases (%.1f%%) · fetched %d of %d, scroll to the end for more\n",
+			label.Name, owned, fetched, completion, fetched, total)
+	}
+	if label.ParentLabel != "" {
+		fmt.Fprintf(&b, "Parent label: %s\n", label.ParentLabel)
+	}
+	if len(label.Sublabels) > 0 {
+		fmt.Fprintf(&b, "Sublabels: %s\n", strings.Join(label.Sublabels, ", "))
+	}
+	if label.Profile != "" {
+		fmt.Fprintf(&b, "\n%s\n", label.Profile)
+	}
+	if label.ContactInfo != "" {
+		fmt.Fprintf(&b, "\nContact:\n%s\n", label.ContactInfo)
+	}
+	for _, url := range label.Urls {
+		fmt.Fprintf(&b, "%s\n", url)
+	}
+	return b.String()
+}
@@ -0,0 +1,210 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// listsNavItem is the index of the Lists entry in the navigation, not counting the folder tree.
+const listsNavItem = 4
+
+// openLists lets the user pick one of their lists, or any public list by ID.
+// The Discogs API doesn't allow editing lists, so they are read only.
+func (t *TUI) openLists() {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		lists, err := t.Client.GetLists(ctx, t.reportProgress("list"))
+		if err != nil {
+			t.showError(err)
+			return
+		}
+
+		t.queueUpdateDraw(func() {
+			list := tview.NewList().ShowSecondaryText(false)
+			list.SetBorder(true).SetTitle("Lists")
+			for _, summary := range lists {
+				summary := summary
+				name := summary.Name
+				if !summary.Public {
+					name += " · private"
+				}
+				list.AddItem(name, "", 0, func() {
+					t.closePage("dialog")
+					go t.loadList(summary.Id)
+				})
+			}
+			list.AddItem("Open a public list by ID...", "", 'g', func() {
+				t.closePage("dialog")
+				t.openListIdForm()
+			})
+			list.SetDoneFunc(func() { t.closePage("dialog") })
+			t.openDialog(list, 60, min(list.GetItemCount()+2, 20))
+		})
+	}()
+}
+
+// openListIdForm asks for the ID of a list, e.g. one shared by another user
+func (t *TUI) openListIdForm() {
+	form := tview.NewForm().AddInputField("List ID", "", 12, tview.InputFieldInteger, nil)
+	form.AddButton("Open", func() {
+		id, err := strconv.Atoi(form.GetFormItemByLabel("List ID").(*tview.InputField).GetText())
+		if err != nil || id <= 0 {
+			t.showWarning("Enter the numeric ID of a list")
+			return
+		}
+		t.closePage("dialog")
+		go t.loadList(id)
+	}).AddButton("Cancel", func() { t.closePage("dialog") })
+	form.SetBorder(true).SetTitle("Open list")
+	form.SetCancelFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(form, 40, 7)
+}
+
+// loadList fetches a list and shows its items as cards in the preview
+func (t *TUI) loadList(id int) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	t.showMessage(fmt.Sprintf("Loading list %d...", id))
+	list, err := t.Client.GetList(ctx, id)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+
+	t.queueUpdateDraw(func() {
+		cards := make([]*tview.Flex, len(list.Items))
+		jobs := make([]thumbnailJob, len(list.Items))
+		for i, item := range list.Items {
+			cards[i], jobs[i] = t.createListItemCard(item)
+		}
+		t.List = list
+		t.ListPrims = cards
+		t.loadListThumbnails(jobs)
+
+		t.SelectedSource = client.ListsSource
+		t.PreviewPosition = [2]int{0, 0}
+		t.Navigation.SetCurrentItem(t.listsItemIndex())
+		t.DrawPreviewGrid()
+		t.focusOnPreview(client.ListsSource)()
+		t.showMessage(fmt.Sprintf("✓ Loaded %s (%d items)", list.Name, len(list.Items)))
+	})
+}
+
+// listsItemIndex returns the index of the Lists entry in the navigation. It's
+// counted rather than searched for, since folder names may contain "Lists".
+func (t *TUI) listsItemIndex() int {
+	return listsNavItem + t.folderItems
+}
+
+// listTitle returns the preview title for the shown list
+func (t *TUI) listTitle() string {
+	if t.List.Id == 0 {
+		return fmt.Sprintf("%s · Lists (press Enter on Lists to pick one)", PreviewTitle)
+	}
+	owner := t.List.Owner
+	if owner == "" {
+		owner = "unknown"
+	}
+	return fmt.Sprintf("%s · %s by %s", PreviewTitle, t.List.Name, owner)
+}
+
+// createListItemCard creates a card for a list item. Releases get a regular release card,
+// other items a card laid out like one.
+func (t *TUI) createListItemCard(item dto.ListItemModel) (*tview.Flex, thumbnailJob) {
+	if item.Type == "release" {
+		model := item.Release()
+		card, thumb := t.createReleaseCard(model)
+		card.SetTitle(model.Title)
+		card.SetInputCapture(t.listCardInput(t.releaseCardInput(model)))
+		return card, thumbnailJob{image: thumb, url: model.ThumbUrl}
+	}
+
+	tmpFlex := tview.NewFlex()
+	thumb := tview.NewImage()
+
+	txt := fmt.Sprintf(
+		`
+	%s
+	Type: %s
+
+	%s
+	`,
+		item.Title,
+		item.Type,
+		strings.ReplaceAll(item.Comment, "\n", "\n\t"),
+	)
+
+	tmpFlex.AddItem(thumb, 0, 1, false)
+	tmpFlex.AddItem(tview.NewTextView().SetText(txt).SetWordWrap(true), 0, 2, false)
+	tmpFlex.SetBorder(true).SetTitle(item.Title).SetTitleAlign(tview.AlignLeft)
+	tmpFlex.SetInputCapture(t.listCardInput(t.listItemInput(item)))
+	return tmpFlex, thumbnailJob{image: thumb, url: item.ImageUrl}
+}
+
+// listItemInput opens the master, artist or label of a focused list item card
+func (t *TUI) listItemInput(item dto.ListItemModel) func(*tcell.EventKey) *tcell.EventKey {
+	return func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyEnter {
+			t.openSearchResult(dto.SearchResultModel{Id: item.Id, Type: item.Type})
+			return nil
+		}
+		return key
+	}
+}
+
+// listCardInput handles the list key bindings of a focused list card and passes other keys to cardInput
+func (t *TUI) listCardInput(cardInput func(*tcell.EventKey) *tcell.EventKey) func(*tcell.EventKey) *tcell.EventKey {
+	return func(key *tcell.EventKey) *tcell.EventKey {
+		switch key.Rune() {
+		case 'o':
+			t.openLists()
+			return nil
+		case 'g':
+			t.openListIdForm()
+			return nil
+		}
+		return cardInput(key)
+	}
+}
+
+// listReleases returns the releases of the shown list by card, with a zero model for the other items
+func (t *TUI) listReleases() []dto.ReleaseModel {
+	models := make([]dto.ReleaseModel, len(t.ListPrims))
+	for i, item := range t.List.Items {
+		if item.Type == "release" && i < len(models) {
+			models[i] = item.Release()
+		}
+	}
+	return models
+}
+
+// loadListThumbnails downloads the thumbnails of a list. It's kept apart from
+// loadThumbnails so opening a list doesn't cancel the thumbnails of the collection.
+func (t *TUI) loadListThumbnails(jobs []thumbnailJob) {
+	if t.cancelListThumbnails != nil {
+		t.cancelListThumbnails()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancelListThumbnails = cancel
+
+	go func() {
+		for _, job := range jobs {
+			if ctx.Err() != nil {
+				return
+			}
+			t.fetchThumbnail(ctx, job)
+		}
+	}()
+}
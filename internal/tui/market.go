@@ -0,0 +1,239 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// marketRetryDelay is how long a failed marketplace fetch is shown as unavailable before it's retried.
+const marketRetryDelay = time.Minute
+
+// marketEntry is cached marketplace data of a release. It is loading until loaded is set.
+type marketEntry[T any] struct {
+	value     T
+	err       error
+	loaded    bool
+	fetchedAt time.Time
+}
+
+// loadVisibleMarketData loads the marketplace data of a focused card first and then of
+// the other cards on the same page of the preview grid.
+func (t *TUI) loadVisibleMarketData(card *tview.Flex, model dto.ReleaseModel) {
+	t.loadMarketData(model)
+
+	cards, models := t.previewReleases()
+	index := slices.Index(cards, card)
+	if index < 0 {
+		return
+	}
+	perPage := t.Config.Grid.NumOfRows * t.Config.Grid.NumOfCols
+	start := index / perPage * perPage
+	for i := start; i < min(start+perPage, len(cards)); i++ {
+		if i != index && models[i].ReleaseId != 0 {
+			t.loadMarketData(models[i])
+		}
+	}
+}
+
+// previewReleases returns the cards of the preview grid with their releases. Cards
+// of other items, e.g. artists in a list, have a zero model.
+func (t *TUI) previewReleases() ([]*tview.Flex, []dto.ReleaseModel) {
+	switch t.SelectedSource {
+	case client.CollectionSource:
+		var cards []*tview.Flex
+		var models []dto.ReleaseModel
+		for i, model := range t.Collection {
+			if t.SelectedFolder == client.AllFolderId || model.FolderId == t.SelectedFolder {
+				cards = append(cards, t.collectionCards[i])
+				models = append(models, model)
+			}
+		}
+		return cards, models
+	case client.WishlistSource:
+		return t.WishlistPrims, t.Wishlist
+	case client.ListsSource:
+		return t.ListPrims, t.listReleases()
+	}
+	return nil, nil
+}
+
+// loadMarketData shows the marketplace data of a focused release card, fetching it on first use.
+// Collection cards get price suggestions, wish list cards the marketplace stats.
+func (t *TUI) loadMarketData(model dto.ReleaseModel) {
+	// Only collection releases have an instance
+	if model.InstanceId != 0 {
+		fetchMarketEntry(t, t.priceSuggestions, model.ReleaseId, t.Client.GetPriceSuggestions)
+	} else {
+		fetchMarketEntry(t, t.marketStats, model.ReleaseId, t.Client.GetReleaseStats)
+	}
+}
+
+// fetchMarketEntry refreshes the cards of a release from the cache, or fetches the entry in the
+// background if it was never requested or failed a while ago. It must be called on the UI goroutine.
+func fetchMarketEntry[T any](t *TUI, cache map[int]*marketEntry[T], releaseId int, fetch func(context.Context, int) (T, error)) {
+	if entry, ok := cache[releaseId]; ok {
+		retry := entry.loaded && entry.err != nil && time.Since(entry.fetchedAt) >= marketRetryDelay
+		if !retry {
+			if entry.loaded {
+				t.refreshReleaseCards(releaseId)
+			}
+			return
+		}
+	}
+
+	entry := &marketEntry[T]{}
+	cache[releaseId] = entry
+	t.refreshReleaseCards(releaseId)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		value, err := fetch(ctx, releaseId)
+		t.queueUpdateDraw(func() {
+			entry.value, entry.err, entry.loaded, entry.fetchedAt = value, err, true, time.Now()
+			t.refreshReleaseCards(releaseId)
+		})
+	}()
+}
+
+// refreshReleaseCards redraws every collection, wish list and list card of a release
+func (t *TUI) refreshReleaseCards(releaseId int) {
+	for i, model := range t.Collection {
+		if model.ReleaseId == releaseId && i < len(t.collectionCards) {
+			t.refreshReleaseCard(t.collectionCards[i], model)
+		}
+	}
+	for i, model := range t.Wishlist {
+		if model.ReleaseId == releaseId && i < len(t.WishlistPrims) {
+			t.refreshReleaseCard(t.WishlistPrims[i], model)
+		}
+	}
+	for i, model := range t.listReleases() {
+		if model.ReleaseId == releaseId {
+			t.refreshReleaseCard(t.ListPrims[i], model)
+		}
+	}
+}
+
+// marketText renders the cached marketplace data of a release card, if any
+func (t *TUI) marketText(model dto.ReleaseModel) string {
+	if model.InstanceId != 0 {
+		entry, ok := t.priceSuggestions[model.ReleaseId]
+		switch {
+		case !ok:
+			return ""
+		case !entry.loaded:
+			return "\tSuggested: loading...\n"
+		case entry.err != nil:
+			return "\tSuggested: unavailable\n"
+		case len(entry.value) == 0:
+			return "\tSuggested: none\n"
+		}
+		if price, ok := entry.value.ForCondition(model.MediaCondition); ok {
+			return fmt.Sprintf("\tSuggested (%s): %s\n", model.MediaCondition, price)
+		}
+		worst, best := entry.value[len(entry.value)-1], entry.value[0]
+		return fmt.Sprintf("\tSuggested: %s – %s\n", worst.Price, best.Price)
+	}
+
+	entry, ok := t.marketStats[model.ReleaseId]
+	switch {
+	case !ok:
+		return ""
+	case !entry.loaded:
+		return "\tMarketplace: loading...\n"
+	case entry.err != nil:
+		return "\tMarketplace: unavailable\n"
+	}
+	return fmt.Sprintf("\tMarketplace: %s\n", statsText(entry.value))
+}
+
+// statsText summarises the marketplace stats of a release on one line
+func statsText(stats dto.ReleaseStatsModel) string {
+	switch {
+	case stats.Blocked:
+		return "blocked from sale"
+	case stats.LowestPrice == nil || stats.NumForSale == 0:
+		return "none for sale"
+	}
+	return fmt.Sprintf("%d for sale from %s", stats.NumForSale, stats.LowestPrice)
+}
+
+// marketDetailText fetches and renders the marketplace data of a release for the detail page.
+// Price suggestions are only shown for releases in the collection.
+func (t *TUI) marketDetailText(ctx context.Context, releaseId int, inCollection bool) string {
+	var b strings.Builder
+	b.WriteString("\nMarketplace\n")
+
+	if stats, err := t.Client.GetReleaseStats(ctx, releaseId); err != nil {
+		b.WriteString("  Stats unavailable\n")
+	} else {
+		fmt.Fprintf(&b, "  %s\n", statsText(stats))
+	}
+
+	if inCollection {
+		suggestions, err := t.Client.GetPriceSuggestions(ctx, releaseId)
+		switch {
+		case err != nil:
+			b.WriteString("  Price suggestions unavailable\n")
+		case len(suggestions) > 0:
+			b.WriteString("  Suggested prices\n")
+			for _, suggestion := range suggestions {
+				fmt.Fprintf(&b, "    %-22s %s\n", suggestion.Condition, suggestion.Price)
+			}
+		}
+	}
+	return b.String()
+}
+
+// openSellForm drafts a listing for a copy in the collection, prefilled with its conditions
+// and the suggested price for its media condition
+func (t *TUI) openSellForm(model dto.ReleaseModel) {
+	update := client.ListingUpdate{
+		ReleaseId: model.ReleaseId,
+		Status:    dto.ListingStatusDraft,
+	}
+	// Conditions are free text in custom fields, only the marketplace grades can be used
+	if slices.Contains(dto.Conditions, model.MediaCondition) {
+		update.Condition = model.MediaCondition
+	}
+	if slices.Contains(dto.SleeveConditions, model.SleeveCondition) {
+		update.SleeveCondition = model.SleeveCondition
+	}
+	title := fmt.Sprintf("Sell %s", model.Title)
+
+	if entry, ok := t.priceSuggestions[model.ReleaseId]; ok && entry.loaded && entry.err == nil {
+		if price, ok := entry.value.ForCondition(update.Condition); ok {
+			update.Price = price.Value
+		}
+		t.openListingForm(title, update, false, t.createListing)
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		// The form still opens without a suggestion, the price is then up to the user
+		suggestions, err := t.Client.GetPriceSuggestions(ctx, model.ReleaseId)
+		if err != nil {
+			t.showWarning(fmt.Sprintf("No price suggestion: %v", err))
+		}
+		t.queueUpdateDraw(func() {
+			t.priceSuggestions[model.ReleaseId] = &marketEntry[dto.PriceSuggestionsModel]{value: suggestions, err: err, loaded: true}
+			t.refreshReleaseCards(model.ReleaseId)
+			if price, ok := suggestions.ForCondition(update.Condition); ok {
+				update.Price = price.Value
+			}
+			t.openListingForm(title, update, false, t.createListing)
+		})
+	}()
+}
@@ -0,0 +1,228 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// masterPage is the name of the master release browser page.
+const masterPage = "master"
+
+// masterBrowser holds the state of the master release browser
+type masterBrowser struct {
+	master   dto.MasterModel
+	query    client.MasterVersionsQuery
+	versions dto.MasterVersionsModel
+
+	header *tview.TextView
+	table  *tview.Table
+}
+
+// openMasterBrowser opens the versions of a master release in a table
+func (t *TUI) openMasterBrowser(masterId int) {
+	if masterId == 0 {
+		t.showWarning("This release has no master release")
+		return
+	}
+
+	b := &masterBrowser{
+		master: dto.MasterModel{Id: masterId},
+		query:  client.MasterVersionsQuery{Page: 1, Sort: "released", SortOrder: "asc"},
+		header: tview.NewTextView(),
+		table:  tview.NewTable().SetSelectable(true, false).SetFixed(1, 0),
+	}
+	b.header.SetText("Loading versions...")
+	b.table.SetBorder(true).SetTitleAlign(tview.AlignLeft)
+	b.table.SetSelectedFunc(func(row, _ int) {
+		if row > 0 && row <= len(b.versions.Versions) {
+			t.openReleaseDetail(b.versions.Versions[row-1].ReleaseId)
+		}
+	})
+
+	page := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(b.header, 3, 0, false).
+		AddItem(b.table, 0, 1, true)
+	page.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyEscape {
+			t.closePage(masterPage)
+			return nil
+		}
+		switch key.Rune() {
+		case 'n':
+			if b.versions.Page < b.versions.Pages {
+				b.query.Page++
+				go t.loadMasterVersions(b, b.master.Id, b.query)
+			}
+			return nil
+		case 'p':
+			if b.query.Page > 1 {
+				b.query.Page--
+				go t.loadMasterVersions(b, b.master.Id, b.query)
+			}
+			return nil
+		case 's':
+			next := (slices.Index(client.MasterVersionSorts, b.query.Sort) + 1) % len(client.MasterVersionSorts)
+			b.query.Sort = client.MasterVersionSorts[next]
+			b.query.Page = 1
+			go t.loadMasterVersions(b, b.master.Id, b.query)
+			return nil
+		case 'o':
+			if b.query.SortOrder == "asc" {
+				b.query.SortOrder = "desc"
+			} else {
+				b.query.SortOrder = "asc"
+			}
+			b.query.Page = 1
+			go t.loadMasterVersions(b, b.master.Id, b.query)
+			return nil
+		case 'f':
+			t.openMasterFilter(b)
+			return nil
+		}
+		return key
+	})
+
+	t.openPage(masterPage, page)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		master, err := t.Client.GetMaster(ctx, masterId)
+		var query client.MasterVersionsQuery
+		t.awaitUpdateDraw(func() {
+			if err == nil {
+				b.master = master
+			}
+			query = b.query
+		})
+		if err != nil {
+			t.showError(err)
+		}
+		t.loadMasterVersions(b, masterId, query)
+	}()
+}
+
+// loadMasterVersions fetches a page of versions and redraws the table. The query is passed by
+// value since the key bindings keep changing b.query; results of an outdated query are dropped.
+func (t *TUI) loadMasterVersions(b *masterBrowser, masterId int, query client.MasterVersionsQuery) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	t.showMessage(fmt.Sprintf("Loading versions page %d...", query.Page))
+	versions, err := t.Client.GetMasterVersions(ctx, masterId, query)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.queueUpdateDraw(func() {
+		if query != b.query {
+			return
+		}
+		b.versions = versions
+		t.renderMasterVersions(b)
+	})
+}
+
+// renderMasterVersions fills the version table, highlighting owned and wanted versions
+func (t *TUI) renderMasterVersions(b *masterBrowser) {
+	b.header.SetText(fmt.Sprintf(
+		"%s – %s (%d)\nSort: %s %s · Filters: format=%q label=%q country=%q year=%q\n"+
+			"Page [ n / p ] · Sort [ s ] · Order [ o ] · Filter [ f ] · Open [ Enter ] · Close [ Esc ]",
+		b.master.Artist, b.master.Title, b.master.Year,
+		b.query.Sort, b.query.SortOrder,
+		b.query.Format, b.query.Label, b.query.Country, b.query.Released,
+	))
+	b.table.SetTitle(fmt.Sprintf("Versions · page %d/%d · %d total", b.versions.Page, b.versions.Pages, b.versions.TotalItems))
+
+	b.table.Clear()
+	for col, title := range []string{"Title", "Format", "Label", "Cat#", "Country", "Year", "Have/Want", ""} {
+		b.table.SetCell(0, col, tview.NewTableCell(title).SetSelectable(false).SetTextColor(tcell.ColorYellow))
+	}
+
+	own := t.ownership()
+	for i, version := range b.versions.Versions {
+		color := tcell.ColorWhite
+		status := ""
+		switch {
+		case version.InCollection || own.owned[version.ReleaseId]:
+			color, status = tcell.ColorGreen, "In collection"
+		case version.InWantlist || own.wanted[version.ReleaseId]:
+			color, status = tcell.ColorOrange, "In wantlist"
+		}
+
+		row := i + 1
+		for col, text := range []string{
+			version.Title,
+			version.Format,
+			version.Label,
+			version.CatNo,
+			version.Country,
+			version.Released,
+			fmt.Sprintf("%d/%d", version.Have, version.Want),
+			status,
+		} {
+			b.table.SetCell(row, col, tview.NewTableCell(tview.Escape(text)).SetTextColor(color).SetMaxWidth(40))
+		}
+	}
+	b.table.Select(1, 0).ScrollToBeginning()
+}
+
+// openMasterFilter asks for the version filters
+func (t *TUI) openMasterFilter(b *masterBrowser) {
+	form := tview.NewForm().
+		AddInputField("Format", b.query.Format, 20, nil, nil).
+		AddInputField("Label", b.query.Label, 20, nil, nil).
+		AddInputField("Country", b.query.Country, 20, nil, nil).
+		AddInputField("Year", b.query.Released, 20, nil, nil)
+	form.AddButton("Apply", func() {
+		b.query.Format = form.GetFormItemByLabel("Format").(*tview.InputField).GetText()
+		b.query.Label = form.GetFormItemByLabel("Label").(*tview.InputField).GetText()
+		b.query.Country = form.GetFormItemByLabel("Country").(*tview.InputField).GetText()
+		b.query.Released = form.GetFormItemByLabel("Year").(*tview.InputField).GetText()
+		b.query.Page = 1
+		t.closePage("dialog")
+		go t.loadMasterVersions(b, b.master.Id, b.query)
+	}).AddButton("Cancel", func() { t.closePage("dialog") })
+	form.SetBorder(true).SetTitle("Filter versions")
+	form.SetCancelFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(form, 40, 13)
+}
+
+// ownershipIndex tells which releases and masters are in the loaded collection and wish list
+type ownershipIndex struct {
+	owned         map[int]bool
+	wanted        map[int]bool
+	ownedMasters  map[int]bool
+	wantedMasters map[int]bool
+}
+
+// ownership indexes the loaded collection and wish list by release and master ID
+func (t *TUI) ownership() ownershipIndex {
+	own := ownershipIndex{
+		owned:         make(map[int]bool, len(t.Collection)),
+		wanted:        make(map[int]bool, len(t.Wishlist)),
+		ownedMasters:  make(map[int]bool),
+		wantedMasters: make(map[int]bool),
+	}
+	for _, model := range t.Collection {
+		own.owned[model.ReleaseId] = true
+		if model.MasterId != 0 {
+			own.ownedMasters[model.MasterId] = true
+		}
+	}
+	for _, model := range t.Wishlist {
+		own.wanted[model.ReleaseId] = true
+		if model.MasterId != 0 {
+			own.wantedMasters[model.MasterId] = true
+		}
+	}
+	return own
+}
@@ -0,0 +1,366 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+const (
+	// allOrderStatuses is the filter entry that disables status filtering.
+	allOrderStatuses = "All statuses"
+	// orderPage is the name of the order detail page.
+	orderPage = "order"
+)
+
+// createOrderCard creates a card summarising an order
+func (t *TUI) createOrderCard(order dto.OrderModel) *tview.Flex {
+	tmpFlex := tview.NewFlex()
+
+	role, counterpart := order.Counterpart(t.Client.Identity.Username)
+	txt := fmt.Sprintf(
+		`
+	%s
+	%s: %s
+	%d item(s)
+
+	Total: %s
+	Shipping: %s %s
+	Created: %s
+	Last activity: %s
+	`,
+		order.Status,
+		role, counterpart,
+		len(order.Items),
+		order.Total,
+		order.Shipping, order.ShippingMethod,
+		order.Created.Format("2006-01-02 15:04"),
+		order.LastActivity.Format("2006-01-02 15:04"),
+	)
+
+	tmpFlex.AddItem(tview.NewTextView().SetText(txt), 0, 1, false)
+	tmpFlex.SetBorder(true).SetTitle(fmt.Sprintf("Order %s", order.Id)).SetTitleAlign(tview.AlignLeft)
+	tmpFlex.SetInputCapture(t.orderCardInput(order))
+	return tmpFlex
+}
+
+// setOrders creates the order cards and applies the current status filter
+func (t *TUI) setOrders(orders []dto.OrderModel) {
+	t.Orders = orders
+	t.orderCards = make([]*tview.Flex, len(orders))
+	for i, order := range orders {
+		t.orderCards[i] = t.createOrderCard(order)
+	}
+	t.applyOrderFilter()
+}
+
+// applyOrderFilter fills OrderPrims with the cards matching OrderStatusFilter
+func (t *TUI) applyOrderFilter() {
+	cards := make([]*tview.Flex, 0, len(t.orderCards))
+	for i, order := range t.Orders {
+		if t.OrderStatusFilter == "" || order.Status == t.OrderStatusFilter {
+			cards = append(cards, t.orderCards[i])
+		}
+	}
+	t.OrderPrims = cards
+}
+
+// orderCardInput handles the key bindings of a focused order card
+func (t *TUI) orderCardInput(order dto.OrderModel) func(*tcell.EventKey) *tcell.EventKey {
+	return func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyEnter {
+			t.openOrderDetail(order)
+			return nil
+		}
+		switch key.Rune() {
+		case 'f':
+			t.openOrderFilter()
+			return nil
+		}
+		return key
+	}
+}
+
+// openOrderFilter shows a picker for the order status filter
+func (t *TUI) openOrderFilter() {
+	counts := make(map[string]int)
+	for _, order := range t.Orders {
+		counts[order.Status]++
+	}
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).SetTitle("Filter orders by status")
+	list.AddItem(fmt.Sprintf("%s (%d)", allOrderStatuses, len(t.Orders)), "", 0, func() {
+		t.setOrderFilter("")
+	})
+	for _, status := range dto.OrderStatuses {
+		status := status
+		list.AddItem(fmt.Sprintf("%s (%d)", status, counts[status]), "", 0, func() {
+			t.setOrderFilter(status)
+		})
+	}
+	list.SetDoneFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(list, 50, len(dto.OrderStatuses)+3)
+}
+
+// setOrderFilter filters the order cards by status and redraws the preview
+func (t *TUI) setOrderFilter(status string) {
+	t.OrderStatusFilter = status
+	t.applyOrderFilter()
+
+	t.PreviewPosition = [2]int{0, 0}
+	t.closePage("dialog")
+	t.DrawPreviewGrid()
+}
+
+// orderDetail holds the views of the order detail page
+type orderDetail struct {
+	order    dto.OrderModel
+	messages []dto.OrderMessageModel
+
+	info   *tview.TextView
+	thread *tview.TextView
+	form   *tview.Form
+}
+
+// openOrderDetail opens the detail page of an order and loads its message thread
+func (t *TUI) openOrderDetail(order dto.OrderModel) {
+	d := &orderDetail{
+		order:  order,
+		info:   tview.NewTextView().SetScrollable(true).SetWrap(true),
+		thread: tview.NewTextView().SetScrollable(true).SetWrap(true).SetWordWrap(true),
+		form:   tview.NewForm(),
+	}
+	d.info.SetBorder(true).SetTitle(fmt.Sprintf("Order %s", order.Id)).SetTitleAlign(tview.AlignLeft)
+	d.thread.SetBorder(true).SetTitle("Messages [ PgUp/PgDn ]").SetTitleAlign(tview.AlignLeft)
+	d.thread.SetText("Loading messages...")
+	d.info.SetText(t.orderInfoText(d))
+
+	d.form.AddTextArea("Message", "", 0, 3, 0, nil).
+		AddButton("Send", func() { t.sendOrderMessage(d) }).
+		AddButton("Status", func() { t.openOrderStatusPicker(d) }).
+		AddButton("Shipping", func() { t.openOrderShippingForm(d) }).
+		AddButton("Close", func() { t.closePage(orderPage) })
+	d.form.SetBorder(true).SetTitle("Reply [ Esc to close ]").SetTitleAlign(tview.AlignLeft)
+	d.form.SetCancelFunc(func() { t.closePage(orderPage) })
+
+	page := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(tview.NewFlex().
+			AddItem(d.info, 0, 1, false).
+			AddItem(d.thread, 0, 2, false), 0, 1, false).
+		AddItem(d.form, 9, 0, true)
+	page.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		switch key.Key() {
+		case tcell.KeyPgUp, tcell.KeyPgDn:
+			d.thread.InputHandler()(key, func(tview.Primitive) {})
+			return nil
+		}
+		return key
+	})
+
+	t.openPage(orderPage, page)
+	go t.loadOrderDetail(d)
+}
+
+// loadOrderDetail refreshes the order and fetches its message thread
+func (t *TUI) loadOrderDetail(d *orderDetail) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	order, err := t.Client.GetOrder(ctx, d.order.Id)
+	if err != nil {
+		t.showError(err)
+		order = d.order
+	}
+	messages, err := t.Client.GetOrderMessages(ctx, d.order.Id)
+	if err != nil {
+		t.showError(err)
+	}
+
+	t.queueUpdateDraw(func() {
+		d.order = order
+		d.messages = messages
+		d.info.SetText(t.orderInfoText(d))
+		d.thread.SetText(orderThreadText(d.messages)).ScrollToEnd()
+	})
+}
+
+// sendOrderMessage posts the text of the reply form to the order thread
+func (t *TUI) sendOrderMessage(d *orderDetail) {
+	input := d.form.GetFormItemByLabel("Message").(*tview.TextArea)
+	text := strings.TrimSpace(input.GetText())
+	if text == "" {
+		t.showWarning("Type a message before sending")
+		return
+	}
+
+	t.showMessage("Sending message...")
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		message, err := t.Client.PostOrderMessage(ctx, d.order.Id, text)
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		t.queueUpdateDraw(func() {
+			input.SetText("", false)
+			d.messages = append(d.messages, message)
+			d.thread.SetText(orderThreadText(d.messages)).ScrollToEnd()
+		})
+		t.showMessage("✓ Message sent")
+	}()
+}
+
+// openOrderStatusPicker lists the statuses the order can move to
+func (t *TUI) openOrderStatusPicker(d *orderDetail) {
+	allowed := client.AllowedOrderTransitions(d.order)
+	if len(allowed) == 0 {
+		t.showWarning(fmt.Sprintf("No status changes allowed from %q", d.order.Status))
+		return
+	}
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).SetTitle(fmt.Sprintf("Change status from %s", d.order.Status))
+	for _, status := range allowed {
+		status := status
+		list.AddItem(status, "", 0, func() {
+			t.closePage("dialog")
+			t.confirm(fmt.Sprintf("Change order %s from %q to %q?", d.order.Id, d.order.Status, status), func() {
+				t.applyOrderUpdate(d, client.OrderUpdate{Status: status})
+			})
+		})
+	}
+	list.SetDoneFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(list, 50, len(allowed)+2)
+}
+
+// openOrderShippingForm asks for a new shipping cost
+func (t *TUI) openOrderShippingForm(d *orderDetail) {
+	if !client.CanEditShipping(d.order) {
+		t.showWarning(fmt.Sprintf("Shipping can no longer be changed for status %q", d.order.Status))
+		return
+	}
+
+	form := tview.NewForm()
+	form.AddInputField(fmt.Sprintf("Shipping (%s)", d.order.Shipping.Currency), strconv.FormatFloat(d.order.Shipping.Value, 'f', 2, 64), 12, acceptPrice, nil).
+		AddButton("Save", func() {
+			input := form.GetFormItem(0).(*tview.InputField)
+			shipping, err := strconv.ParseFloat(input.GetText(), 64)
+			if err != nil {
+				t.showWarning(fmt.Sprintf("Invalid shipping cost %q", input.GetText()))
+				return
+			}
+			t.closePage("dialog")
+			t.confirm(fmt.Sprintf("Set shipping of order %s to %.2f %s?", d.order.Id, shipping, d.order.Shipping.Currency), func() {
+				t.applyOrderUpdate(d, client.OrderUpdate{Shipping: &shipping})
+			})
+		}).
+		AddButton("Cancel", func() { t.closePage("dialog") })
+	form.SetBorder(true).SetTitle("Shipping cost")
+	form.SetCancelFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(form, 40, 7)
+}
+
+// applyOrderUpdate sends an order update and refreshes the detail page and the order card
+func (t *TUI) applyOrderUpdate(d *orderDetail, update client.OrderUpdate) {
+	t.showMessage(fmt.Sprintf("Updating order %s...", d.order.Id))
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		order, err := t.Client.UpdateOrder(ctx, d.order, update)
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		t.queueUpdateDraw(func() {
+			d.order = order
+			d.info.SetText(t.orderInfoText(d))
+			t.replaceOrder(order)
+		})
+		t.showMessage(fmt.Sprintf("✓ Order %s updated", order.Id))
+		// Status changes add messages to the thread
+		t.loadOrderDetail(d)
+	}()
+}
+
+// replaceOrder swaps an updated order and its card into the Orders view
+func (t *TUI) replaceOrder(order dto.OrderModel) {
+	for i := range t.Orders {
+		if t.Orders[i].Id == order.Id {
+			t.Orders[i] = order
+			t.orderCards[i] = t.createOrderCard(order)
+		}
+	}
+	t.applyOrderFilter()
+	t.DrawPreviewGrid()
+}
+
+// orderInfoText renders items, shipping and status history of an order
+func (t *TUI) orderInfoText(d *orderDetail) string {
+	order := d.order
+	role, counterpart := order.Counterpart(t.Client.Identity.Username)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Status: %s\n", order.Status)
+	fmt.Fprintf(&b, "%s: %s\n", role, counterpart)
+	fmt.Fprintf(&b, "Created: %s\n", order.Created.Format("2006-01-02 15:04"))
+	fmt.Fprintf(&b, "Last activity: %s\n", order.LastActivity.Format("2006-01-02 15:04"))
+
+	b.WriteString("\nItems:\n")
+	for _, item := range order.Items {
+		fmt.Fprintf(&b, "  • %s\n    %s · Media: %s · Sleeve: %s\n",
+			item.Description, item.Price, item.MediaCondition, item.SleeveCondition)
+	}
+
+	fmt.Fprintf(&b, "\nShipping: %s %s\n", order.Shipping, order.ShippingMethod)
+	fmt.Fprintf(&b, "Fee: %s\n", order.Fee)
+	fmt.Fprintf(&b, "Total: %s\n", order.Total)
+
+	b.WriteString("\nShipping address:\n")
+	for _, line := range strings.Split(order.ShippingAddress, "\n") {
+		fmt.Fprintf(&b, "  %s\n", line)
+	}
+	if order.Instructions != "" {
+		fmt.Fprintf(&b, "\nInstructions:\n  %s\n", order.Instructions)
+	}
+
+	b.WriteString("\nStatus history:\n")
+	for _, message := range d.messages {
+		if message.IsStatusChange() {
+			fmt.Fprintf(&b, "  %s  %s\n", message.Timestamp.Format("2006-01-02 15:04"), message.Message)
+		}
+	}
+	return b.String()
+}
+
+// orderThreadText renders the conversation messages of an order
+func orderThreadText(messages []dto.OrderMessageModel) string {
+	var b strings.Builder
+	for _, message := range messages {
+		if message.IsStatusChange() {
+			continue
+		}
+		fmt.Fprintf(&b, "%s · %s\n", message.Timestamp.Format("2006-01-02 15:04"), message.From)
+		if message.Subject != "" {
+			fmt.Fprintf(&b, "%s\n", message.Subject)
+		}
+		fmt.Fprintf(&b, "%s\n\n", message.Message)
+	}
+	if b.Len() == 0 {
+		return "No messages yet."
+	}
+	return b.String()
+}
@@ -0,0 +1,142 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// releasePage is the name of the release detail page.
+const releasePage = "release"
+
+// openReleaseDetail opens a scrollable page with the full details of a release
+func (t *TUI) openReleaseDetail(releaseId int) {
+	view := tview.NewTextView().SetScrollable(true).SetWrap(true).SetWordWrap(true)
+	view.SetBorder(true).SetTitle("Release [ Esc to close ]").SetTitleAlign(tview.AlignLeft)
+	view.SetText("Loading release...")
+	view.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			t.closePage(releasePage)
+		}
+	})
+	t.openPage(releasePage, view)
+
+	inCollection := t.ownership().owned[releaseId]
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		release, err := t.Client.GetRelease(ctx, releaseId)
+		if err != nil {
+			t.showError(err)
+			t.queueUpdateDraw(func() {
+				view.SetText(fmt.Sprintf("Failed to load release %d", releaseId))
+			})
+			return
+		}
+		t.awaitUpdateDraw(func() {
+			view.SetTitle(fmt.Sprintf("%s [ Esc to close ]", release.Title))
+			view.SetText(releaseDetailText(release) + t.collectionDetailText(releaseId)).ScrollToBeginning()
+		})
+
+		market := t.marketDetailText(ctx, releaseId, inCollection)
+		t.queueUpdateDraw(func() {
+			fmt.Fprint(view, market)
+		})
+	}()
+}
+
+// releaseDetailText renders the full details of a release
+func releaseDetailText(release dto.ReleaseDetailModel) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n%s\n\n", release.Title, release.Artist)
+	fmt.Fprintf(&b, "Label: %s\n", strings.Join(release.Labels, ", "))
+	fmt.Fprintf(&b, "Format: %s\n", release.Format)
+	fmt.Fprintf(&b, "Country: %s · Released: %s\n", release.Country, release.Released)
+	fmt.Fprintf(&b, "Genre: %s\n", release.Genre)
+	fmt.Fprintf(&b, "Style: %s\n", release.Style)
+	fmt.Fprintf(&b, "Community: have %d · want %d · rating %.2f/5 (%d votes)\n",
+		release.Have, release.Want, release.RatingAverage, release.RatingCount)
+
+	b.WriteString("\nTracklist\n")
+	for _, track := range release.Tracklist {
+		if track.Heading {
+			fmt.Fprintf(&b, "\n  %s\n", track.Title)
+			continue
+		}
+		title := track.Title
+		if track.Artists != "" {
+			title = fmt.Sprintf("%s – %s", track.Artists, track.Title)
+		}
+		fmt.Fprintf(&b, "  %-6s %s", track.Position, title)
+		if track.Duration != "" {
+			fmt.Fprintf(&b, " (%s)", track.Duration)
+		}
+		b.WriteString("\n")
+		for _, credit := range track.Credits {
+			fmt.Fprintf(&b, "         %s – %s\n", credit.Role, credit.Name)
+		}
+	}
+
+	if len(release.Credits) > 0 {
+		b.WriteString("\nCredits\n")
+		for _, credit := range release.Credits {
+			fmt.Fprintf(&b, "  %s – %s", credit.Role, credit.Name)
+			if credit.Tracks != "" {
+				fmt.Fprintf(&b, " (tracks %s)", credit.Tracks)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(release.Companies) > 0 {
+		b.WriteString("\nCompanies\n")
+		for _, company := range release.Companies {
+			fmt.Fprintf(&b, "  %s – %s", company.Role, company.Name)
+			if company.CatNo != "" {
+				fmt.Fprintf(&b, " (%s)", company.CatNo)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(release.Identifiers) > 0 {
+		b.WriteString("\nIdentifiers\n")
+		for _, identifier := range release.Identifiers {
+			fmt.Fprintf(&b, "  %s: %s", identifier.Type, identifier.Value)
+			if identifier.Description != "" {
+				fmt.Fprintf(&b, " (%s)", identifier.Description)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if release.Notes != "" {
+		fmt.Fprintf(&b, "\nNotes\n%s\n", release.Notes)
+	}
+	return b.String()
+}
+
+// collectionDetailText renders the copies of a release in the collection with all their custom fields
+func (t *TUI) collectionDetailText(releaseId int) string {
+	var b strings.Builder
+	for _, model := range t.Collection {
+		if model.ReleaseId != releaseId {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString("\nIn your collection\n")
+		}
+		fmt.Fprintf(&b, "\n  %s · Rating: %s\n", t.folderName(model.FolderId), ratingStars(model.Rating))
+		for _, field := range model.Fields {
+			value := strings.ReplaceAll(field.Value, "\n", "\n    ")
+			fmt.Fprintf(&b, "    %s: %s\n", field.Name, value)
+		}
+	}
+	return b.String()
+}
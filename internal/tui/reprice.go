@@ -0,0 +1,232 @@
+package tui
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// repricePage is the name of the bulk reprice preview page.
+const repricePage = "reprice"
+
+// repriceListings returns the selected listings, or the listing under the cursor if none is selected
+func (t *TUI) repriceListings() []dto.ListingModel {
+	if len(t.inventorySelected) == 0 {
+		if listing, ok := t.selectedListing(); ok {
+			return []dto.ListingModel{listing}
+		}
+		return nil
+	}
+	listings := make([]dto.ListingModel, 0, len(t.inventorySelected))
+	for _, listing := range t.inventorySelected {
+		listings = append(listings, listing)
+	}
+	slices.SortFunc(listings, func(a, b dto.ListingModel) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+	return listings
+}
+
+// openRepriceForm asks for the reprice rule of the selected listings
+func (t *TUI) openRepriceForm() {
+	listings := t.repriceListings()
+	if len(listings) == 0 {
+		t.showWarning("Select listings to reprice with [ Space ] first")
+		return
+	}
+
+	form := tview.NewForm().
+		AddCheckbox("Match price suggestion", false, nil).
+		AddInputField("Change (%)", "", 10, acceptPercent, nil).
+		AddInputField("Floor", "", 10, acceptPrice, nil).
+		AddInputField("Ceiling", "", 10, acceptPrice, nil).
+		AddCheckbox("Round to .99", false, nil)
+	form.AddButton("Preview", func() {
+		number := func(label string) float64 {
+			value, _ := strconv.ParseFloat(form.GetFormItemByLabel(label).(*tview.InputField).GetText(), 64)
+			return value
+		}
+		rule := client.RepriceRule{
+			MatchSuggestion: form.GetFormItemByLabel("Match price suggestion").(*tview.Checkbox).IsChecked(),
+			Percent:         number("Change (%)"),
+			Floor:           number("Floor"),
+			Ceiling:         number("Ceiling"),
+			Round99:         form.GetFormItemByLabel("Round to .99").(*tview.Checkbox).IsChecked(),
+		}
+		// Keep the form open so mistakes can be fixed
+		if err := rule.Validate(); err != nil {
+			t.showError(err)
+			return
+		}
+		t.closePage("dialog")
+		go t.planReprice(listings, rule)
+	}).AddButton("Cancel", func() { t.closePage("dialog") })
+	form.SetBorder(true).SetTitle(fmt.Sprintf("Reprice %d listing(s)", len(listings)))
+	form.SetCancelFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(form, 50, 15)
+}
+
+// acceptPercent is an InputField acceptance func for signed percentages
+func acceptPercent(text string, _ rune) bool {
+	if text == "" || text == "-" {
+		return true
+	}
+	_, err := strconv.ParseFloat(text, 64)
+	return err == nil
+}
+
+// planReprice computes the new prices and opens the preview page
+func (t *TUI) planReprice(listings []dto.ListingModel, rule client.RepriceRule) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	changes, err := t.Client.PlanReprice(ctx, listings, rule, t.reportProgress("planned"))
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.queueUpdateDraw(func() {
+		t.openRepricePreview(changes)
+	})
+}
+
+// openRepricePreview shows the before/after prices and applies them once confirmed
+func (t *TUI) openRepricePreview(changes []client.PriceChange) {
+	header := tview.NewTextView()
+	table := tview.NewTable().SetSelectable(true, false).SetFixed(1, 0)
+	table.SetBorder(true).SetTitle("Reprice preview").SetTitleAlign(tview.AlignLeft)
+
+	count := 0
+	for _, change := range changes {
+		if change.Changed() {
+			count++
+		}
+	}
+	header.SetText(fmt.Sprintf("%d of %d listing(s) will change\nApply [ y ] · Close [ Esc ]", count, len(changes)))
+	renderRepriceTable(table, changes, nil)
+
+	// The preview can be applied once, afterwards it only shows the report
+	applying, applied := false, false
+	page := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(header, 2, 0, false).
+		AddItem(table, 0, 1, true)
+	page.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyEscape {
+			if applying {
+				t.showWarning("Repricing is still running")
+				return nil
+			}
+			t.closePage(repricePage)
+			return nil
+		}
+		if key.Rune() == 'y' && !applying && !applied && count > 0 {
+			t.confirm(fmt.Sprintf("Update the price of %d listing(s)?", count), func() {
+				applying, applied = true, true
+				header.SetText("Applying prices...")
+				go t.applyReprice(changes, header, table, func() { applying = false })
+			})
+			return nil
+		}
+		return key
+	})
+
+	t.openPage(repricePage, page)
+}
+
+// applyReprice saves the prices and turns the preview into a per listing report
+func (t *TUI) applyReprice(changes []client.PriceChange, header *tview.TextView, table *tview.Table, done func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	results := t.Client.ApplyReprice(ctx, changes, t.reportProgress("repriced"))
+
+	updated, failed := 0, 0
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			failed++
+		case result.Change.Changed():
+			updated++
+		}
+	}
+	t.queueUpdateDraw(func() {
+		done()
+		header.SetText(fmt.Sprintf("%d updated · %d failed · %d unchanged\nClose [ Esc ]",
+			updated, failed, len(results)-updated-failed))
+		renderRepriceTable(table, changes, results)
+
+		// Failed listings stay selected so they can be retried
+		for _, result := range results {
+			if result.Err == nil {
+				delete(t.inventorySelected, result.Change.Listing.Id)
+			}
+		}
+	})
+	t.showMessage(fmt.Sprintf("✓ Repriced %d listing(s), %d failed", updated, failed))
+	t.reloadInventory()
+}
+
+// renderRepriceTable fills the preview table, with a result column once the prices were applied
+func renderRepriceTable(table *tview.Table, changes []client.PriceChange, results []client.RepriceResult) {
+	table.Clear()
+	for col, title := range []string{"Listing", "Release", "Condition", "Before", "After", "Change", "Result"} {
+		table.SetCell(0, col, tview.NewTableCell(title).SetSelectable(false).SetTextColor(tcell.ColorYellow))
+	}
+
+	for i, change := range changes {
+		currency := change.Listing.Price.Currency
+		before := dto.PriceDto{Currency: currency, Value: change.Before}
+		after := dto.PriceDto{Currency: currency, Value: change.After}
+
+		color := tcell.ColorWhite
+		diff := ""
+		status := ""
+		switch {
+		case change.Skipped != nil:
+			color, status = tcell.ColorGray, fmt.Sprintf("Skipped: %v", change.Skipped)
+		case !change.Changed():
+			color, status = tcell.ColorGray, "Unchanged"
+		default:
+			if change.Before > 0 {
+				diff = fmt.Sprintf("%+.1f%%", (change.After-change.Before)/change.Before*100)
+			}
+		}
+		if results != nil {
+			switch err := results[i].Err; {
+			case err != nil:
+				var apiErr *client.APIError
+				if errors.As(err, &apiErr) {
+					status = fmt.Sprintf("Failed: %s", apiErr.Message)
+				} else {
+					status = fmt.Sprintf("Failed: %v", err)
+				}
+				color = tcell.ColorRed
+			case change.Changed():
+				color, status = tcell.ColorGreen, "Updated"
+			}
+		}
+
+		for col, text := range []string{
+			strconv.Itoa(change.Listing.Id),
+			change.Listing.Description,
+			change.Listing.Condition,
+			before.String(),
+			after.String(),
+			diff,
+			status,
+		} {
+			table.SetCell(i+1, col, tview.NewTableCell(tview.Escape(text)).SetTextColor(color).SetMaxWidth(40))
+		}
+	}
+	table.Select(1, 0).ScrollToBeginning()
+}
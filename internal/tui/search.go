@@ -0,0 +1,399 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// searchPage is the name of the database search page.
+const searchPage = "search"
+
+// searchTypeAll is the type option that doesn't filter by result type.
+const searchTypeAll = "all"
+
+// searchBrowser holds the state of the database search page
+type searchBrowser struct {
+	query   client.SearchQuery
+	results dto.SearchResultsModel
+
+	form   *tview.Form
+	table  *tview.Table
+	thumb  *tview.Image
+	info   *tview.TextView
+	thumbs map[string]image.Image
+
+	cancelThumbs context.CancelFunc
+}
+
+// openSearch opens the database search page
+func (t *TUI) openSearch() {
+	b := &searchBrowser{
+		query:  client.SearchQuery{Page: 1},
+		form:   tview.NewForm(),
+		table:  tview.NewTable().SetSelectable(true, false).SetFixed(1, 0),
+		thumb:  tview.NewImage(),
+		info:   tview.NewTextView().SetWrap(true).SetWordWrap(true),
+		thumbs: make(map[string]image.Image),
+	}
+
+	types := append([]string{searchTypeAll}, client.SearchTypes...)
+	b.form.
+		AddInputField("Query", "", 0, nil, nil).
+		AddDropDown("Type", types, 0, nil).
+		AddInputField("Artist", "", 0, nil, nil).
+		AddInputField("Title", "", 0, nil, nil).
+		AddInputField("Label", "", 0, nil, nil).
+		AddInputField("Cat#", "", 0, nil, nil).
+		AddInputField("Barcode", "", 0, nil, nil).
+		AddInputField("Format", "", 0, nil, nil).
+		AddInputField("Country", "", 0, nil, nil).
+		AddInputField("Year", "", 0, nil, nil).
+		AddButton("Search", func() {
+			b.query = searchQueryFromForm(b.form)
+			go t.loadSearchResults(b)
+		}).
+		AddButton("Close", func() { t.closeSearch(b) })
+	b.form.SetBorder(true).SetTitle("Search").SetTitleAlign(tview.AlignLeft)
+
+	b.table.SetBorder(true).SetTitle("Results").SetTitleAlign(tview.AlignLeft)
+	b.table.SetSelectedFunc(func(row, _ int) {
+		if result, ok := b.selected(); ok {
+			t.openSearchResult(result)
+		}
+	})
+	b.table.SetSelectionChangedFunc(func(_, _ int) {
+		t.showSearchSelection(b)
+	})
+	b.info.SetBorder(true).SetTitleAlign(tview.AlignLeft)
+	b.info.SetText("Fill in any of the filters and press Search.\n\n" +
+		"Results: Page [ n / p ] · Open [ Enter ] · Add to collection [ c ] · Add to wantlist [ w ] · Back to filters [ Esc ]")
+
+	page := tview.NewFlex().
+		AddItem(b.form, 40, 0, true).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(b.table, 0, 2, false).
+			AddItem(tview.NewFlex().
+				AddItem(b.thumb, 0, 1, false).
+				AddItem(b.info, 0, 3, false), 0, 1, false), 0, 1, false)
+	page.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		if !b.table.HasFocus() {
+			if key.Key() == tcell.KeyEscape {
+				t.closeSearch(b)
+				return nil
+			}
+			return key
+		}
+
+		if key.Key() == tcell.KeyEscape {
+			t.App.SetFocus(b.form)
+			return nil
+		}
+		switch key.Rune() {
+		case 'n':
+			if b.results.Page < b.results.Pages {
+				b.query.Page++
+				go t.loadSearchResults(b)
+			}
+			return nil
+		case 'p':
+			if b.query.Page > 1 {
+				b.query.Page--
+				go t.loadSearchResults(b)
+			}
+			return nil
+		case 'c':
+			t.addSearchResultToCollection(b)
+			return nil
+		case 'w':
+			t.addSearchResultToWantlist(b)
+			return nil
+		}
+		return key
+	})
+
+	t.openPage(searchPage, page)
+}
+
+// closeSearch closes the search page and stops fetching its thumbnails
+func (t *TUI) closeSearch(b *searchBrowser) {
+	if b.cancelThumbs != nil {
+		b.cancelThumbs()
+	}
+	t.closePage(searchPage)
+}
+
+// searchQueryFromForm reads the search filters from the form, starting at the first page
+func searchQueryFromForm(form *tview.Form) client.SearchQuery {
+	text := func(label string) string {
+		return form.GetFormItemByLabel(label).(*tview.InputField).GetText()
+	}
+	query := client.SearchQuery{
+		Page:    1,
+		Query:   text("Query"),
+		Artist:  text("Artist"),
+		Title:   text("Title"),
+		Label:   text("Label"),
+		CatNo:   text("Cat#"),
+		Barcode: text("Barcode"),
+		Format:  text("Format"),
+		Country: text("Country"),
+		Year:    text("Year"),
+	}
+	if _, option := form.GetFormItemByLabel("Type").(*tview.DropDown).GetCurrentOption(); option != searchTypeAll {
+		query.Type = option
+	}
+	return query
+}
+
+// selected returns the search result under the table cursor
+func (b *searchBrowser) selected() (dto.SearchResultModel, bool) {
+	row, _ := b.table.GetSelection()
+	if row <= 0 || row > len(b.results.Results) {
+		return dto.SearchResultModel{}, false
+	}
+	return b.results.Results[row-1], true
+}
+
+// loadSearchResults fetches the current page of results and redraws the table
+func (t *TUI) loadSearchResults(b *searchBrowser) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	t.showMessage(fmt.Sprintf("Searching page %d...", b.query.Page))
+	results, err := t.Client.Search(ctx, b.query)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.queueUpdateDraw(func() {
+		b.results = results
+		t.renderSearchResults(b)
+		t.loadSearchThumbnails(b)
+		if len(results.Results) > 0 {
+			t.App.SetFocus(b.table)
+		}
+	})
+}
+
+// loadSearchThumbnails fetches the thumbnails of the current result page in the background,
+// starting at the selected result. A new page cancels the previous run.
+func (t *TUI) loadSearchThumbnails(b *searchBrowser) {
+	if b.cancelThumbs != nil {
+		b.cancelThumbs()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancelThumbs = cancel
+
+	results := b.results.Results
+	row, _ := b.table.GetSelection()
+	start := max(row-1, 0)
+	seen := make(map[string]bool)
+	var urls []string
+	for i := range len(results) {
+		url := results[(start+i)%len(results)].ThumbUrl
+		if url == "" || seen[url] || b.thumbs[url] != nil {
+			continue
+		}
+		seen[url] = true
+		urls = append(urls, url)
+	}
+
+	go func() {
+		for _, url := range urls {
+			if ctx.Err() != nil {
+				return
+			}
+			thumbCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			img, err := t.Client.GetThumbImageWithContext(thumbCtx, url)
+			cancel()
+			if err != nil {
+				continue
+			}
+			t.queueUpdateDraw(func() {
+				b.thumbs[url] = img
+				if current, ok := b.selected(); ok && current.ThumbUrl == url {
+					b.thumb.SetImage(img)
+				}
+			})
+		}
+	}()
+}
+
+// renderSearchResults fills the result table, highlighting owned and wanted releases
+func (t *TUI) renderSearchResults(b *searchBrowser) {
+	b.table.SetTitle(fmt.Sprintf("Results · page %d/%d · %d total", b.results.Page, b.results.Pages, b.results.TotalItems))
+
+	b.table.Clear()
+	for col, title := range []string{"Type", "Title", "Format", "Label", "Cat#", "Country", "Year"} {
+		b.table.SetCell(0, col, tview.NewTableCell(title).SetSelectable(false).SetTextColor(tcell.ColorYellow))
+	}
+
+	own := t.ownership()
+	for i, result := range b.results.Results {
+		color := tcell.ColorWhite
+		switch {
+		case result.Type != "release":
+		case result.InCollection || own.owned[result.Id]:
+			color = tcell.ColorGreen
+		case result.InWantlist || own.wanted[result.Id]:
+			color = tcell.ColorOrange
+		}
+
+		row := i + 1
+		for col, text := range []string{
+			result.Type,
+			result.Title,
+			result.Format,
+			result.Label,
+			result.CatNo,
+			result.Country,
+			result.Year,
+		} {
+			b.table.SetCell(row, col, tview.NewTableCell(tview.Escape(text)).SetTextColor(color).SetMaxWidth(40))
+		}
+	}
+	b.table.Select(1, 0).ScrollToBeginning()
+	t.showSearchSelection(b)
+}
+
+// showSearchSelection shows the details and thumbnail of the selected result
+func (t *TUI) showSearchSelection(b *searchBrowser) {
+	b.thumb.SetImage(nil)
+
+	result, ok := b.selected()
+	if !ok {
+		b.info.SetTitle("")
+		b.info.SetText("No results")
+		return
+	}
+
+	status := ""
+	own := t.ownership()
+	switch {
+	case result.Type != "release":
+	case result.InCollection || own.owned[result.Id]:
+		status = "In collection"
+	case result.InWantlist || own.wanted[result.Id]:
+		status = "In wantlist"
+	}
+	b.info.SetTitle(result.Type)
+	b.info.SetText(fmt.Sprintf(
+		"%s\n%s | %s | %s %s\n%s\nGenre: %s\nStyle: %s\n%s",
+		result.Title,
+		result.Year, result.Country, result.Label, result.CatNo,
+		result.Format,
+		result.Genre,
+		result.Style,
+		status,
+	))
+
+	// Thumbnails that are still loading are set by loadSearchThumbnails
+	if img, ok := b.thumbs[result.ThumbUrl]; ok {
+		b.thumb.SetImage(img)
+	}
+}
+
+// openSearchResult opens the page matching the type of the result
+func (t *TUI) openSearchResult(result dto.SearchResultModel) {
+	switch result.Type {
+	case "release":
+		t.openReleaseDetail(result.Id)
+	case "master":
+		t.openMasterBrowser(result.Id)
+	case "artist":
+		t.openArtistPage(result.Id)
+	case "label":
+		t.openLabelPage(result.Id)
+	}
+}
+
+// addSearchResultToCollection adds the selected release to a folder picked by the user
+func (t *TUI) addSearchResultToCollection(b *searchBrowser) {
+	result, ok := b.selected()
+	if !ok {
+		return
+	}
+	if result.Type != "release" {
+		t.showWarning("Only releases can be added to the collection")
+		return
+	}
+
+	t.pickFolder("Add to folder", func(folder dto.FolderModel) {
+		t.App.SetFocus(b.table)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			instanceId, err := t.Client.AddToFolder(ctx, folder.Id, result.Id)
+			if err != nil {
+				t.showError(err)
+				return
+			}
+			t.showMessage(fmt.Sprintf("✓ Added %s to %s", result.Title, folder.Name))
+			t.queueUpdateDraw(func() {
+				t.markSearchResult(b, result.Id, func(r *dto.SearchResultModel) { r.InCollection = true })
+			})
+
+			// The add only returns the instance ID, the new copy is fetched to show its card
+			instances, err := t.Client.GetReleaseInstances(ctx, result.Id)
+			if err != nil {
+				t.showWarning(fmt.Sprintf("Failed to load the new copy of %s: %v", result.Title, err))
+				return
+			}
+			for _, instance := range instances {
+				if instance.InstanceId == instanceId {
+					t.awaitUpdateDraw(func() { t.insertCollectionRelease(instance) })
+				}
+			}
+			t.refreshFolders(ctx)
+		}()
+	})
+}
+
+// addSearchResultToWantlist adds the selected release to the wantlist
+func (t *TUI) addSearchResultToWantlist(b *searchBrowser) {
+	result, ok := b.selected()
+	if !ok {
+		return
+	}
+	if result.Type != "release" {
+		t.showWarning("Only releases can be added to the wantlist")
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		want, err := t.Client.AddToWantlist(ctx, result.Id)
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		t.showMessage(fmt.Sprintf("✓ Added %s to your wantlist", result.Title))
+		t.awaitUpdateDraw(func() {
+			t.insertWishlistRelease(want)
+			t.markSearchResult(b, result.Id, func(r *dto.SearchResultModel) { r.InWantlist = true })
+		})
+		t.DrawPreviewGrid()
+	}()
+}
+
+// markSearchResult updates a result in place and redraws its row, keeping the selection
+func (t *TUI) markSearchResult(b *searchBrowser, id int, update func(*dto.SearchResultModel)) {
+	for i := range b.results.Results {
+		if b.results.Results[i].Id == id && b.results.Results[i].Type == "release" {
+			update(&b.results.Results[i])
+		}
+	}
+	row, col := b.table.GetSelection()
+	t.renderSearchResults(b)
+	b.table.Select(row, col)
+}
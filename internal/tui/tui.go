@@ -3,6 +3,8 @@ package tui
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
@@ -33,16 +35,44 @@ type TUI struct {
 	Pages      *tview.Pages
 	Grid       *tview.Grid
 	Navigation *tview.List
+	ValuePanel *tview.TextView
 	Footer     *tview.TextView
 
 	Preview         *tview.Grid
 	CollectionPrims []*tview.Flex
 	WishlistPrims   []*tview.Flex
 	OrderPrims      []*tview.Flex
+	InventoryTable  *tview.Table
+	ListPrims       []*tview.Flex
+
+	Collection        []dto.ReleaseModel
+	Wishlist          []dto.ReleaseModel
+	Orders            []dto.OrderModel
+	Inventory         dto.InventoryModel
+	InventoryQuery    client.InventoryQuery
+	Folders           []dto.FolderModel
+	CollectionValue   dto.CollectionValueModel
+	SelectedFolder    int
+	OrderStatusFilter string
+	collectionCards   []*tview.Flex
+	orderCards        []*tview.Flex
+	folderItems       int
+	inventoryLoaded   bool
+	inventorySelected map[int]dto.ListingModel
+	Jobs              []dto.InventoryJobModel
+	List              dto.ListModel
+	jobsPanel         *jobsPanel
 
 	SelectedSource  client.DataSource
 	PreviewPosition [2]int
 	LastUpdated     time.Time
+
+	cancelThumbnails     context.CancelFunc
+	cancelListThumbnails context.CancelFunc
+
+	// marketStats and priceSuggestions cache the marketplace data of releases by release ID
+	marketStats      map[int]*marketEntry[dto.ReleaseStatsModel]
+	priceSuggestions map[int]*marketEntry[dto.PriceSuggestionsModel]
 }
 
 // New creates a new TUI instance.
@@ -51,21 +81,30 @@ func New(c *client.DiscogsClient, config *configs.AppConfig) *TUI {
 	t.App = tview.NewApplication()
 	t.Client = c
 	t.Config = config
+	t.marketStats = make(map[int]*marketEntry[dto.ReleaseStatsModel])
+	t.priceSuggestions = make(map[int]*marketEntry[dto.PriceSuggestionsModel])
 
-	// menu list
+	// menu list, the folder tree is inserted below Collection. Moving Lists means updating listsNavItem.
 	t.Navigation = tview.NewList()
 	t.Navigation.SetBorder(true).SetTitle(MenuTitle).SetBackgroundColor(tcell.ColorBlack)
 	t.Navigation.
 		AddItem("Collection", "Display the releases in your Collection", '0', t.focusOnPreview(client.CollectionSource)).
 		AddItem("Wish list", "Display the releases in your Wish list", '1', t.focusOnPreview(client.WishlistSource)).
 		AddItem("Orders", "Check the status of your Orders", '2', t.focusOnPreview(client.OrdersSource)).
+		AddItem("Inventory", "Manage your marketplace listings", '3', t.focusOnPreview(client.InventorySource)).
+		AddItem("Lists", "Browse your lists and public lists", '4', t.openLists).
+		AddItem("Search", "Search the Discogs database", 's', t.openSearch).
 		AddItem("Quit", "Press to exit", 'q', func() { t.App.Stop() })
 	t.Navigation.SetChangedFunc(t.sourceSelected)
+	t.Navigation.SetInputCapture(t.navigationInput)
+	t.ValuePanel = tview.NewTextView().SetText("Loading...")
+	t.ValuePanel.SetBorder(true).SetTitle("Collection value").SetBackgroundColor(tcell.ColorBlack)
 	leftPanel := tview.NewGrid().
-		SetRows(0, 0).
+		SetRows(0, 6, 0).
 		SetBorders(false).
 		AddItem(t.Navigation, 0, 0, 1, 1, 0, 0, true).
-		AddItem(getLogoPrimitive(), 1, 0, 1, 1, 0, 0, false)
+		AddItem(t.ValuePanel, 1, 0, 1, 1, 0, 0, false).
+		AddItem(getLogoPrimitive(), 2, 0, 1, 1, 0, 0, false)
 
 	// preview grid
 	rowSlice := make([]int, config.Grid.NumOfRows)
@@ -75,7 +114,11 @@ func New(c *client.DiscogsClient, config *configs.AppConfig) *TUI {
 	t.Preview.SetTitle(PreviewTitle)
 	t.Preview.SetBorder(true)
 
-	t.Footer = tview.NewTextView().SetTextAlign(tview.AlignCenter).SetText(FooterText).SetTextColor(tcell.ColorGray)
+	t.InventoryTable = t.createInventoryTable()
+	t.InventoryQuery = client.InventoryQuery{Page: 1, Sort: "listed", SortOrder: "desc"}
+	t.inventorySelected = make(map[int]dto.ListingModel)
+
+	t.Footer = tview.NewTextView().SetTextAlign(tview.AlignCenter).SetText(t.footerText()).SetTextColor(tcell.ColorGray)
 
 	t.Grid = tview.NewGrid().
 		SetRows(0, 2).
@@ -115,8 +158,6 @@ func (t *TUI) Start() error {
 			select {
 			case <-ticker.C:
 				if time.Since(t.LastUpdated) >= updateFreq {
-					// update all data
-					t.showMessage(fmt.Sprintf("ticker triggered and we have this selected: %v", t.SelectedSource))
 					t.DrawPreviewGrid()
 				}
 			}
@@ -144,9 +185,29 @@ func (tui *TUI) queueUpdateDraw(f func()) {
 	}()
 }
 
+// awaitUpdateDraw runs f on the UI goroutine and waits for it, so updates made
+// from a background goroutine are applied in order. Never call it from the UI goroutine.
+func (tui *TUI) awaitUpdateDraw(f func()) {
+	done := make(chan struct{})
+	tui.queueUpdateDraw(func() {
+		defer close(done)
+		f()
+	})
+	<-done
+}
+
+// footerText returns FooterText followed by the remaining Discogs request budget
+func (t *TUI) footerText() string {
+	budget := t.Client.RateLimit()
+	if budget.UpdatedAt.IsZero() {
+		return FooterText
+	}
+	return fmt.Sprintf("%s · API budget %d/%d", FooterText, budget.Remaining, budget.Limit)
+}
+
 func (t *TUI) resetMessage() {
 	t.queueUpdateDraw(func() {
-		t.Footer.SetText(FooterText).SetTextColor(tcell.ColorGray)
+		t.Footer.SetText(t.footerText()).SetTextColor(tcell.ColorGray)
 	})
 }
 
@@ -166,85 +227,129 @@ func (t *TUI) showWarning(msg string) {
 
 func (t *TUI) showError(err error) {
 	t.queueUpdateDraw(func() {
-		t.Preview.Clear()
 		t.Footer.SetText(err.Error()).SetTextColor(tcell.ColorRed)
 	})
 	go time.AfterFunc(50*time.Second, t.resetMessage)
 }
 
-func (t *TUI) createReleaseCardPrimitive(model dto.ReleaseModel) (*tview.Flex, error) {
-	tmpFlex := tview.NewFlex() //.SetDirection(tview.FlexRow)
-	thumbImg, err := t.Client.GetThumbImage(model.ThumbUrl)
-	if err != nil {
-		fmt.Printf("Error at GetThumbImage: %v \n", err)
-		return nil, err
-	}
+// centered wraps p in a fixed size box in the middle of the screen
+func centered(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
 
-	// Card content
-	txt := fmt.Sprintf(
-		`
-	%s
-	%s | %d | %s
-	%s
+// openPage shows content as a full screen page on top of the current one, keeping the footer visible
+func (t *TUI) openPage(name string, content tview.Primitive) {
+	layout := tview.NewGrid().
+		SetRows(0, 2).
+		SetBorders(false).
+		AddItem(content, 0, 0, 1, 1, 0, 0, true).
+		AddItem(t.Footer, 1, 0, 1, 1, 0, 0, false)
+	t.Pages.AddPage(name, layout, true, true)
+	t.App.SetFocus(content)
+}
 
+// closePage removes a page opened with openPage or confirm and restores the focus below it
+func (t *TUI) closePage(name string) {
+	t.Pages.RemovePage(name)
+	if front, _ := t.Pages.GetFrontPage(); front == "main" {
+		t.App.SetFocus(t.Preview)
+		t.handlePreviewNavigation(tcell.KeyEnd)
+	}
+}
 
-	Condition: %s
-	Sleeve Condition: %s
-	Genre: %s
-	Style: %s
-	`,
-		model.Title,
-		model.Artist, model.Year, model.Label,
-		model.Format,
-		model.MediaCondition,
-		model.SleeveCondition,
-		model.Genre,
-		model.Style,
-	)
+// openDialog shows p as a centered dialog on top of the current page
+func (t *TUI) openDialog(p tview.Primitive, width, height int) {
+	t.Pages.AddPage("dialog", centered(p, width, height), true, true)
+	t.App.SetFocus(p)
+}
 
-	tmpFlex.AddItem(tview.NewImage().SetImage(thumbImg), 0, 1, false)
-	tmpFlex.AddItem(tview.NewTextView().SetText(
-		txt,
-	), 0, 2, false)
-	tmpFlex.SetBorder(true).SetTitle("Release").SetTitleAlign(tview.AlignLeft)
-	return tmpFlex, nil
+// acceptPrice is an InputField acceptance func for decimal amounts
+func acceptPrice(text string, _ rune) bool {
+	if text == "" {
+		return true
+	}
+	_, err := strconv.ParseFloat(text, 64)
+	return err == nil
+}
+
+// confirm asks a yes/no question on top of the current page and calls onYes if confirmed
+func (t *TUI) confirm(question string, onYes func()) {
+	modal := tview.NewModal().
+		SetText(question).
+		AddButtons([]string{"Yes", "No"}).
+		SetDoneFunc(func(_ int, label string) {
+			t.closePage("confirm")
+			if label == "Yes" {
+				onYes()
+			}
+		})
+	t.Pages.AddPage("confirm", modal, true, true)
+	t.App.SetFocus(modal)
 }
 
 func (t *TUI) DrawPreviewGrid() {
-	t.queueUpdateDraw(func() {
-		t.Preview.Clear()
-
-		var cards []*tview.Flex
-		switch t.SelectedSource {
-		case client.CollectionSource:
-			cards = t.CollectionPrims
-		case client.WishlistSource:
-			cards = t.WishlistPrims
-		case client.OrdersSource:
-			cards = t.OrderPrims
-		}
-		for i := range len(cards) {
-			row := i / t.Config.Grid.NumOfCols
-			column := i % t.Config.Grid.NumOfCols
+	t.queueUpdateDraw(t.drawPreviewGrid)
+}
+
+// drawPreviewGrid fills the preview with the cards of the selected source. It must be called on the UI goroutine.
+func (t *TUI) drawPreviewGrid() {
+	t.Preview.Clear()
 
-			t.Preview.AddItem(cards[i], row, column, 1, 1, 0, 0, false)
+	var cards []*tview.Flex
+	title := PreviewTitle
+	switch t.SelectedSource {
+	case client.CollectionSource:
+		cards = t.CollectionPrims
+		if t.SelectedFolder != client.AllFolderId {
+			title = fmt.Sprintf("%s · %s", PreviewTitle, t.folderName(t.SelectedFolder))
 		}
+	case client.WishlistSource:
+		cards = t.WishlistPrims
+	case client.OrdersSource:
+		cards = t.OrderPrims
+		if t.OrderStatusFilter != "" {
+			title = fmt.Sprintf("%s · %s", PreviewTitle, t.OrderStatusFilter)
+		}
+	case client.ListsSource:
+		cards = t.ListPrims
+		title = t.listTitle()
+	}
+	if t.SelectedSource == client.InventorySource {
+		// The inventory is paged by the API, so it's shown as a table instead of cards
+		t.Preview.SetTitle(t.inventoryTitle())
+		t.Preview.AddItem(t.InventoryTable, 0, 0, t.Config.Grid.NumOfRows, t.Config.Grid.NumOfCols, 0, 0, false)
 		t.LastUpdated = time.Now()
-	})
+		return
+	}
+	t.Preview.SetTitle(title)
+	for i := range len(cards) {
+		row := i / t.Config.Grid.NumOfCols
+		column := i % t.Config.Grid.NumOfCols
+
+		t.Preview.AddItem(cards[i], row, column, 1, 1, 0, 0, false)
+	}
+	t.LastUpdated = time.Now()
 }
 
 // LoadDataWithContext loads the data from all sources with context support
 func (t *TUI) LoadDataWithContext(ctx context.Context) error {
-	t.Preview.Clear()
-
 	// Add timeout for the entire loading process
 	loadCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
 
 	t.showMessage("Loading your Discogs data...")
 
+	// Thumbnails are fetched after the lists so they don't eat the request budget
+	var thumbnails []thumbnailJob
+
 	// Creating collection cards
-	collections, err := t.Client.GetCollection()
+	collections, err := t.Client.GetCollectionWithContext(loadCtx, t.reportProgress("collection"))
 	if err != nil {
 		t.showError(err)
 		return err
@@ -253,7 +358,7 @@ func (t *TUI) LoadDataWithContext(ctx context.Context) error {
 	t.showMessage(fmt.Sprintf("Loading %d collection items...", len(collections)))
 
 	collectionCards := make([]*tview.Flex, 0, len(collections))
-	for i, model := range collections {
+	for _, model := range collections {
 		// Check if context is cancelled
 		select {
 		case <-loadCtx.Done():
@@ -261,33 +366,37 @@ func (t *TUI) LoadDataWithContext(ctx context.Context) error {
 		default:
 		}
 
-		// Use context-aware method for thumbnail loading
-		card, err := t.createReleaseCardPrimitiveWithContext(loadCtx, model)
-		if err != nil {
-			fmt.Printf("Warning: Failed to create card for %s: %v\n", model.Title, err)
-			// Create a text-only card as fallback
-			card = t.createTextOnlyCard(model)
-		}
+		card, thumb := t.createReleaseCard(model)
+		card.SetTitle(model.Title)
+		card.SetInputCapture(t.collectionCardInput(model))
+		collectionCards = append(collectionCards, card)
+		thumbnails = append(thumbnails, thumbnailJob{image: thumb, url: model.ThumbUrl})
+	}
+	t.Collection = collections
+	t.collectionCards = collectionCards
 
-		if card != nil {
-			card.SetTitle(model.Title)
-			card.SetInputCapture(t.openReleaseModal)
-			collectionCards = append(collectionCards, card)
-		}
+	folders, err := t.Client.GetFolders(loadCtx)
+	if err != nil {
+		t.showWarning(fmt.Sprintf("Failed to load folders: %v", err))
+		folders = nil
+	}
+	t.setFolders(folders)
 
-		// Update progress
-		if i%10 == 0 {
-			t.showMessage(fmt.Sprintf("Loaded %d/%d collection items...", i+1, len(collections)))
-		}
+	value, err := t.Client.GetCollectionValue(loadCtx)
+	if err != nil {
+		// Keep showing the last known value
+		t.showWarning(fmt.Sprintf("Failed to load collection value: %v", err))
+	} else {
+		t.setCollectionValue(value)
 	}
-	t.CollectionPrims = collectionCards
 
 	// Creating wishlist cards
 	t.showMessage("Loading wishlist...")
-	wants, err := t.Client.GetWishlist()
+	wants, err := t.Client.GetWishlistWithContext(loadCtx, t.reportProgress("wishlist"))
 	if err != nil {
 		t.showWarning(fmt.Sprintf("Failed to load wishlist: %v", err))
 		// Don't fail completely, just continue without wishlist
+		t.Wishlist = nil
 		t.WishlistPrims = []*tview.Flex{}
 	} else {
 		wantCards := make([]*tview.Flex, 0, len(wants))
@@ -298,55 +407,52 @@ func (t *TUI) LoadDataWithContext(ctx context.Context) error {
 			default:
 			}
 
-			card, err := t.createReleaseCardPrimitiveWithContext(loadCtx, model)
-			if err != nil {
-				fmt.Printf("Warning: Failed to create wishlist card for %s: %v\n", model.Title, err)
-				card = t.createTextOnlyCard(model)
-			}
-
-			if card != nil {
-				card.SetTitle(model.Title)
-				wantCards = append(wantCards, card)
-			}
+			card, thumb := t.createReleaseCard(model)
+			card.SetTitle(model.Title)
+			card.SetInputCapture(t.wishlistCardInput(model))
+			wantCards = append(wantCards, card)
+			thumbnails = append(thumbnails, thumbnailJob{image: thumb, url: model.ThumbUrl})
 		}
+		t.Wishlist = wants
 		t.WishlistPrims = wantCards
 	}
 
 	// Creating order cards
 	t.showMessage("Loading orders...")
-	orders, err := t.Client.GetOrders()
+	orders, err := t.Client.GetOrdersWithContext(loadCtx, t.reportProgress("order"))
 	if err != nil {
 		t.showWarning(fmt.Sprintf("Failed to load orders: %v", err))
 		// Don't fail completely, just continue without orders
-		t.OrderPrims = []*tview.Flex{}
-	} else {
-		orderCards := make([]*tview.Flex, 0, len(orders))
-		for _, model := range orders {
-			select {
-			case <-loadCtx.Done():
-				return loadCtx.Err()
-			default:
-			}
-
-			card, err := t.createReleaseCardPrimitiveWithContext(loadCtx, model)
-			if err != nil {
-				fmt.Printf("Warning: Failed to create order card for %s: %v\n", model.Title, err)
-				card = t.createTextOnlyCard(model)
-			}
-
-			if card != nil {
-				card.SetTitle(model.Title)
-				orderCards = append(orderCards, card)
-			}
-		}
-		t.OrderPrims = orderCards
+		orders = nil
 	}
+	t.setOrders(orders)
+
+	t.loadThumbnails(thumbnails)
 
 	t.showMessage("✓ Data loading complete!")
 	time.AfterFunc(2*time.Second, t.resetMessage)
 	return nil
 }
 
+// setCollectionValue shows the collection value and when it was fetched in the value panel
+func (t *TUI) setCollectionValue(value dto.CollectionValueModel) {
+	t.queueUpdateDraw(func() {
+		t.CollectionValue = value
+		t.ValuePanel.SetText(fmt.Sprintf(
+			"Minimum: %s\nMedian:  %s\nMaximum: %s\nFetched %s",
+			value.Minimum, value.Median, value.Maximum,
+			value.FetchedAt.Format("2006-01-02 15:04"),
+		))
+	})
+}
+
+// reportProgress returns a client.ProgressFunc that reports fetched pages in the footer
+func (t *TUI) reportProgress(kind string) client.ProgressFunc {
+	return func(fetched, total int) {
+		t.showMessage(fmt.Sprintf("Fetched %d/%d %s items...", fetched, total, kind))
+	}
+}
+
 // LoadData maintains backward compatibility but with timeout
 func (t *TUI) LoadData() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
@@ -354,77 +460,111 @@ func (t *TUI) LoadData() error {
 	return t.LoadDataWithContext(ctx)
 }
 
-// createReleaseCardPrimitiveWithContext creates a release card with context support
-func (t *TUI) createReleaseCardPrimitiveWithContext(ctx context.Context, model dto.ReleaseModel) (*tview.Flex, error) {
+// createReleaseCard creates a release card with an empty thumbnail that is filled in by loadThumbnails
+func (t *TUI) createReleaseCard(model dto.ReleaseModel) (*tview.Flex, *tview.Image) {
 	tmpFlex := tview.NewFlex()
+	thumb := tview.NewImage()
 
-	// Use context-aware thumbnail loading with shorter timeout
-	thumbCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-
-	thumbImg, err := t.Client.GetThumbImageWithContext(thumbCtx, model.ThumbUrl)
-	if err != nil {
-		// Instead of failing, create a text-only card
-		return t.createTextOnlyCard(model), nil
-	}
+	tmpFlex.AddItem(thumb, 0, 1, false)
+	tmpFlex.AddItem(tview.NewTextView().SetText(releaseCardText(model)), 0, 2, false)
+	tmpFlex.SetBorder(true).SetTitle("Release").SetTitleAlign(tview.AlignLeft)
+	// Marketplace data is only fetched for the page of a focused card to spare the request budget
+	tmpFlex.SetFocusFunc(func() { t.loadVisibleMarketData(tmpFlex, model) })
+	return tmpFlex, thumb
+}
 
-	// Card content
-	txt := fmt.Sprintf(
+// releaseCardText renders the content of a release card
+func releaseCardText(model dto.ReleaseModel) string {
+	return fmt.Sprintf(
 		`
 	%s
 	%s | %d | %s
 	%s
 
-
-	Condition: %s
-	Sleeve Condition: %s
-	Genre: %s
+	Rating: %s
+%s	Genre: %s
 	Style: %s
 	`,
 		model.Title,
 		model.Artist, model.Year, model.Label,
 		model.Format,
-		model.MediaCondition,
-		model.SleeveCondition,
+		ratingStars(model.Rating),
+		fieldsText(model),
 		model.Genre,
 		model.Style,
 	)
+}
 
-	tmpFlex.AddItem(tview.NewImage().SetImage(thumbImg), 0, 1, false)
-	tmpFlex.AddItem(tview.NewTextView().SetText(txt), 0, 2, false)
-	tmpFlex.SetBorder(true).SetTitle("Release").SetTitleAlign(tview.AlignLeft)
-	return tmpFlex, nil
+// fieldsText renders the custom fields of a release, one indented line each
+func fieldsText(model dto.ReleaseModel) string {
+	// Wish list releases have no custom fields, only notes
+	if len(model.Fields) == 0 {
+		return fmt.Sprintf("\tNotes: %s\n", strings.ReplaceAll(model.Note, "\n", " "))
+	}
+	var b strings.Builder
+	for _, field := range model.Fields {
+		fmt.Fprintf(&b, "\t%s: %s\n", field.Name, strings.ReplaceAll(field.Value, "\n", " "))
+	}
+	return b.String()
 }
 
-// createTextOnlyCard creates a card without thumbnail as fallback
-func (t *TUI) createTextOnlyCard(model dto.ReleaseModel) *tview.Flex {
-	tmpFlex := tview.NewFlex()
+// ratingStars renders a 0-5 rating as stars
+func ratingStars(rating uint8) string {
+	rating = min(rating, client.MaxRating)
+	return strings.Repeat("★", int(rating)) + strings.Repeat("☆", client.MaxRating-int(rating))
+}
 
-	txt := fmt.Sprintf(
-		`
-	%s
-	%s | %d | %s
-	%s
+// refreshReleaseCard redraws the text of a card created by createReleaseCard, including its marketplace data
+func (t *TUI) refreshReleaseCard(card *tview.Flex, model dto.ReleaseModel) {
+	card.GetItem(1).(*tview.TextView).SetText(releaseCardText(model) + t.marketText(model))
+	card.SetTitle(model.Title)
+}
 
-	Condition: %s
-	Sleeve Condition: %s
-	Genre: %s
-	Style: %s
-	
-	[Thumbnail unavailable]
-	`,
-		model.Title,
-		model.Artist, model.Year, model.Label,
-		model.Format,
-		model.MediaCondition,
-		model.SleeveCondition,
-		model.Genre,
-		model.Style,
-	)
+// thumbnailJob is a card image waiting for its thumbnail to be downloaded
+type thumbnailJob struct {
+	image *tview.Image
+	url   string
+}
 
-	tmpFlex.AddItem(tview.NewTextView().SetText(txt), 0, 1, false)
-	tmpFlex.SetBorder(true).SetTitle("Release").SetTitleAlign(tview.AlignLeft)
-	return tmpFlex
+// loadThumbnails downloads thumbnails one by one in the background so the
+// client's rate limiter can pace them. A new call cancels the previous run.
+func (t *TUI) loadThumbnails(jobs []thumbnailJob) {
+	if t.cancelThumbnails != nil {
+		t.cancelThumbnails()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancelThumbnails = cancel
+
+	go func() {
+		for _, job := range jobs {
+			if ctx.Err() != nil {
+				return
+			}
+			t.fetchThumbnail(ctx, job)
+		}
+	}()
+}
+
+// loadThumbnail downloads a single card thumbnail, e.g. for a card added after the initial load
+func (t *TUI) loadThumbnail(image *tview.Image, url string) {
+	t.fetchThumbnail(context.Background(), thumbnailJob{image: image, url: url})
+}
+
+// fetchThumbnail downloads the thumbnail of a job and sets it on its image
+func (t *TUI) fetchThumbnail(ctx context.Context, job thumbnailJob) {
+	if job.url == "" {
+		return
+	}
+
+	thumbCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	img, err := t.Client.GetThumbImageWithContext(thumbCtx, job.url)
+	if err != nil {
+		return
+	}
+	t.queueUpdateDraw(func() {
+		job.image.SetImage(img)
+	})
 }
 
 // StartWithContext starts the TUI with context support
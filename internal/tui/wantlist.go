@@ -0,0 +1,134 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/s-froghyar/disgo-tui/internal/client"
+	"github.com/s-froghyar/disgo-tui/internal/dto"
+)
+
+// wishlistCardInput handles the wish list key bindings of a focused release card
+func (t *TUI) wishlistCardInput(model dto.ReleaseModel) func(*tcell.EventKey) *tcell.EventKey {
+	releaseInput := t.releaseCardInput(model)
+	return func(key *tcell.EventKey) *tcell.EventKey {
+		index := t.wishlistIndex(model.ReleaseId)
+		if index < 0 {
+			return releaseInput(key)
+		}
+		current := t.Wishlist[index]
+
+		switch key.Rune() {
+		case 'e':
+			t.openWantForm(current)
+			return nil
+		case 'x':
+			t.confirm(fmt.Sprintf("Remove %s from your wantlist?", current.Title), func() {
+				go t.removeWant(current)
+			})
+			return nil
+		}
+		return releaseInput(key)
+	}
+}
+
+// wishlistIndex returns the position of a release in Wishlist or -1
+func (t *TUI) wishlistIndex(releaseId int) int {
+	for i, model := range t.Wishlist {
+		if model.ReleaseId == releaseId {
+			return i
+		}
+	}
+	return -1
+}
+
+// insertWishlistRelease adds the card of a new want to the wish list. Must run on the UI goroutine.
+func (t *TUI) insertWishlistRelease(model dto.ReleaseModel) {
+	if t.wishlistIndex(model.ReleaseId) >= 0 {
+		return
+	}
+	card, thumb := t.createReleaseCard(model)
+	card.SetTitle(model.Title)
+	card.SetInputCapture(t.wishlistCardInput(model))
+	t.Wishlist = append(t.Wishlist, model)
+	t.WishlistPrims = append(t.WishlistPrims, card)
+	go t.loadThumbnail(thumb, model.ThumbUrl)
+}
+
+// openWantForm lets the user edit the notes and rating of a want
+func (t *TUI) openWantForm(model dto.ReleaseModel) {
+	ratings := make([]string, client.MaxRating+1)
+	for i := range ratings {
+		ratings[i] = ratingStars(uint8(i))
+	}
+
+	form := tview.NewForm().
+		AddTextArea("Notes", model.Note, 0, 4, 0, nil).
+		AddDropDown("Rating", ratings, int(min(model.Rating, client.MaxRating)), nil)
+	form.AddButton("Save", func() {
+		notes := strings.TrimSpace(form.GetFormItemByLabel("Notes").(*tview.TextArea).GetText())
+		rating, _ := form.GetFormItemByLabel("Rating").(*tview.DropDown).GetCurrentOption()
+		t.closePage("dialog")
+		if notes != model.Note || uint8(rating) != model.Rating {
+			go t.editWant(model, notes, uint8(rating))
+		}
+	}).AddButton("Cancel", func() { t.closePage("dialog") })
+	form.SetBorder(true).SetTitle(fmt.Sprintf("Edit %s", model.Title))
+	form.SetCancelFunc(func() { t.closePage("dialog") })
+
+	t.openDialog(form, 70, 11)
+}
+
+// editWant saves the notes and rating of a want and refreshes its card
+func (t *TUI) editWant(model dto.ReleaseModel, notes string, rating uint8) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := t.Client.EditWant(ctx, model.ReleaseId, notes, rating); err != nil {
+		t.showError(err)
+		return
+	}
+
+	t.queueUpdateDraw(func() {
+		if index := t.wishlistIndex(model.ReleaseId); index >= 0 {
+			t.Wishlist[index].Note = notes
+			t.Wishlist[index].Rating = rating
+			t.refreshReleaseCard(t.WishlistPrims[index], t.Wishlist[index])
+		}
+	})
+	t.showMessage(fmt.Sprintf("✓ Saved %s", model.Title))
+}
+
+// removeWant removes a release from the wantlist and drops its card
+func (t *TUI) removeWant(model dto.ReleaseModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := t.Client.RemoveFromWantlist(ctx, model.ReleaseId); err != nil {
+		t.showError(err)
+		return
+	}
+
+	t.awaitUpdateDraw(func() {
+		if index := t.wishlistIndex(model.ReleaseId); index >= 0 {
+			t.Wishlist = slices.Delete(t.Wishlist, index, index+1)
+			t.WishlistPrims = slices.Delete(t.WishlistPrims, index, index+1)
+			if t.PreviewPosition[0]+t.PreviewPosition[1] >= len(t.WishlistPrims) {
+				t.PreviewPosition = [2]int{0, 0}
+			}
+		}
+	})
+	t.showMessage(fmt.Sprintf("✓ Removed %s from your wantlist", model.Title))
+	t.DrawPreviewGrid()
+	t.queueUpdateDraw(func() {
+		if front, _ := t.Pages.GetFrontPage(); front == "main" && t.SelectedSource == client.WishlistSource {
+			t.App.SetFocus(t.Preview)
+			t.handlePreviewNavigation(tcell.KeyEnd)
+		}
+	})
+}